@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"go.uber.org/zap"
+	"mc-player-service/internal/app"
+	"mc-player-service/internal/config"
+	"os/signal"
+	"strings"
+	"syscall"
+)
+
+type command func(ctx context.Context, cfg config.Config, log *zap.SugaredLogger, args []string) error
+
+// commands are one-off tasks run with `mc-player-service <command> [flags]` instead of starting the service
+var commands = map[string]command{
+	"reconcile-experience": reconcileExperience,
+	"resync-role-badges":   resyncRoleBadges,
+	"import-badges":        importBadges,
+}
+
+type offlineCommand func(args []string) error
+
+// offlineCommands don't need the service config, so they can be run anywhere, e.g. in CI
+var offlineCommands = map[string]offlineCommand{
+	"validate-badges": validateBadges,
+}
+
+func runCommand(cfg config.Config, log *zap.SugaredLogger, name string, args []string) error {
+	cmd, ok := commands[name]
+	if !ok {
+		return fmt.Errorf("unknown command %s", name)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	return cmd(ctx, cfg, log, args)
+}
+
+func reconcileExperience(ctx context.Context, cfg config.Config, log *zap.SugaredLogger, args []string) error {
+	flags := flag.NewFlagSet("reconcile-experience", flag.ExitOnError)
+	repair := flags.Bool("repair", false, "overwrite mismatched experience with the ledger total (dry run if false)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	return app.ReconcileExperience(ctx, cfg, log, *repair)
+}
+
+func resyncRoleBadges(ctx context.Context, cfg config.Config, log *zap.SugaredLogger, args []string) error {
+	flags := flag.NewFlagSet("resync-role-badges", flag.ExitOnError)
+	apply := flags.Bool("apply", false, "add and remove badges (dry run if false)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	return app.ResyncRoleBadges(ctx, cfg, log, *apply)
+}
+
+func importBadges(ctx context.Context, cfg config.Config, log *zap.SugaredLogger, args []string) error {
+	flags := flag.NewFlagSet("import-badges", flag.ExitOnError)
+	path := flags.String("path", config.DefaultBadgeConfigPath, "the badge config file or the directory containing config.yaml")
+	overwrite := flags.Bool("overwrite", false, "replace badges that are already in the database")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	return app.ImportBadges(ctx, cfg, log, *path, *overwrite)
+}
+
+func validateBadges(args []string) error {
+	flags := flag.NewFlagSet("validate-badges", flag.ExitOnError)
+	path := flags.String("path", config.DefaultBadgeConfigPath, "the badge config file or the directory containing config.yaml")
+	roles := flags.String("roles", "", "comma separated permission role IDs that automatic grants may use (not checked if empty)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	badgeCfg, err := config.LoadBadgeConfigFrom(*path)
+	if err != nil {
+		return fmt.Errorf("failed to load badge config: %w", err)
+	}
+
+	var knownRoles []string
+	if *roles != "" {
+		knownRoles = strings.Split(*roles, ",")
+	}
+
+	if err := badgeCfg.Validate(knownRoles); err != nil {
+		return err
+	}
+
+	fmt.Printf("badge config is valid (%d badges)\n", len(badgeCfg.Badges))
+	return nil
+}
@@ -6,9 +6,20 @@ import (
 	"log"
 	"mc-player-service/internal/app"
 	"mc-player-service/internal/config"
+	"os"
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		if cmd, ok := offlineCommands[os.Args[1]]; ok {
+			if err := cmd(os.Args[2:]); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+
 	cfg, err := config.LoadGlobalConfig()
 	if err != nil {
 		panic(fmt.Sprintf("failed to load config: %v", err))
@@ -20,6 +31,13 @@ func main() {
 	}
 	log := unsugared.Sugar()
 
+	if len(os.Args) > 1 {
+		if err := runCommand(cfg, log, os.Args[1], os.Args[2:]); err != nil {
+			log.Fatalw("command failed", "command", os.Args[1], "error", err)
+		}
+		return
+	}
+
 	app.Run(cfg, log)
 }
 
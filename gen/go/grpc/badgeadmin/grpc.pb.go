@@ -0,0 +1,3840 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.24.4
+// source: badge/grpc.proto
+
+package badgeadmin
+
+import (
+	common "github.com/emortalmc/proto-specs/gen/go/model/common"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SetPlayerGitHubAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId       string  `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	GithubUsername *string `protobuf:"bytes,2,opt,name=github_username,json=githubUsername,proto3,oneof" json:"github_username,omitempty"`
+}
+
+func (x *SetPlayerGitHubAccountRequest) Reset() {
+	*x = SetPlayerGitHubAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPlayerGitHubAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPlayerGitHubAccountRequest) ProtoMessage() {}
+
+func (x *SetPlayerGitHubAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPlayerGitHubAccountRequest.ProtoReflect.Descriptor instead.
+func (*SetPlayerGitHubAccountRequest) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SetPlayerGitHubAccountRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *SetPlayerGitHubAccountRequest) GetGithubUsername() string {
+	if x != nil && x.GithubUsername != nil {
+		return *x.GithubUsername
+	}
+	return ""
+}
+
+type SetPlayerGitHubAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetPlayerGitHubAccountResponse) Reset() {
+	*x = SetPlayerGitHubAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPlayerGitHubAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPlayerGitHubAccountResponse) ProtoMessage() {}
+
+func (x *SetPlayerGitHubAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPlayerGitHubAccountResponse.ProtoReflect.Descriptor instead.
+func (*SetPlayerGitHubAccountResponse) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{1}
+}
+
+type BadgeGrant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BadgeId string `protobuf:"bytes,1,opt,name=badge_id,json=badgeId,proto3" json:"badge_id,omitempty"`
+	// granted_at is not present for badges granted before grant times were recorded
+	GrantedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=granted_at,json=grantedAt,proto3,oneof" json:"granted_at,omitempty"`
+	// expires_at is not present if the badge is permanent
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+}
+
+func (x *BadgeGrant) Reset() {
+	*x = BadgeGrant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BadgeGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BadgeGrant) ProtoMessage() {}
+
+func (x *BadgeGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BadgeGrant.ProtoReflect.Descriptor instead.
+func (*BadgeGrant) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BadgeGrant) GetBadgeId() string {
+	if x != nil {
+		return x.BadgeId
+	}
+	return ""
+}
+
+func (x *BadgeGrant) GetGrantedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GrantedAt
+	}
+	return nil
+}
+
+func (x *BadgeGrant) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type AddTemporaryBadgeToPlayerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId  string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	BadgeId   string                 `protobuf:"bytes,2,opt,name=badge_id,json=badgeId,proto3" json:"badge_id,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *AddTemporaryBadgeToPlayerRequest) Reset() {
+	*x = AddTemporaryBadgeToPlayerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddTemporaryBadgeToPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTemporaryBadgeToPlayerRequest) ProtoMessage() {}
+
+func (x *AddTemporaryBadgeToPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTemporaryBadgeToPlayerRequest.ProtoReflect.Descriptor instead.
+func (*AddTemporaryBadgeToPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AddTemporaryBadgeToPlayerRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *AddTemporaryBadgeToPlayerRequest) GetBadgeId() string {
+	if x != nil {
+		return x.BadgeId
+	}
+	return ""
+}
+
+func (x *AddTemporaryBadgeToPlayerRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type AddTemporaryBadgeToPlayerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AddTemporaryBadgeToPlayerResponse) Reset() {
+	*x = AddTemporaryBadgeToPlayerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddTemporaryBadgeToPlayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTemporaryBadgeToPlayerResponse) ProtoMessage() {}
+
+func (x *AddTemporaryBadgeToPlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTemporaryBadgeToPlayerResponse.ProtoReflect.Descriptor instead.
+func (*AddTemporaryBadgeToPlayerResponse) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{4}
+}
+
+type GetPlayerBadgeGrantsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+}
+
+func (x *GetPlayerBadgeGrantsRequest) Reset() {
+	*x = GetPlayerBadgeGrantsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlayerBadgeGrantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerBadgeGrantsRequest) ProtoMessage() {}
+
+func (x *GetPlayerBadgeGrantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerBadgeGrantsRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerBadgeGrantsRequest) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetPlayerBadgeGrantsRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type GetPlayerBadgeGrantsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Grants []*BadgeGrant `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants,omitempty"`
+}
+
+func (x *GetPlayerBadgeGrantsResponse) Reset() {
+	*x = GetPlayerBadgeGrantsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlayerBadgeGrantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerBadgeGrantsResponse) ProtoMessage() {}
+
+func (x *GetPlayerBadgeGrantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerBadgeGrantsResponse.ProtoReflect.Descriptor instead.
+func (*GetPlayerBadgeGrantsResponse) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetPlayerBadgeGrantsResponse) GetGrants() []*BadgeGrant {
+	if x != nil {
+		return x.Grants
+	}
+	return nil
+}
+
+type ResetActivePlayerBadgeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+}
+
+func (x *ResetActivePlayerBadgeRequest) Reset() {
+	*x = ResetActivePlayerBadgeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetActivePlayerBadgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetActivePlayerBadgeRequest) ProtoMessage() {}
+
+func (x *ResetActivePlayerBadgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetActivePlayerBadgeRequest.ProtoReflect.Descriptor instead.
+func (*ResetActivePlayerBadgeRequest) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ResetActivePlayerBadgeRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type ResetActivePlayerBadgeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ResetActivePlayerBadgeResponse) Reset() {
+	*x = ResetActivePlayerBadgeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetActivePlayerBadgeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetActivePlayerBadgeResponse) ProtoMessage() {}
+
+func (x *ResetActivePlayerBadgeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetActivePlayerBadgeResponse.ProtoReflect.Descriptor instead.
+func (*ResetActivePlayerBadgeResponse) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{8}
+}
+
+type BadgeAuditRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PlayerId string `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// badge_id is empty for a set_active or reset_active record if the player is left with no badge
+	BadgeId string `protobuf:"bytes,3,opt,name=badge_id,json=badgeId,proto3" json:"badge_id,omitempty"`
+	// action is one of grant, revoke, set_active, reset_active or expire
+	Action string `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	// actor is who made the change, e.g. a staff member, "role-sync" or "automatic"
+	Actor string `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`
+	// source is what made the change, e.g. "grpc", "github" or "level-reward"
+	Source    string                 `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
+	Reason    string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *BadgeAuditRecord) Reset() {
+	*x = BadgeAuditRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BadgeAuditRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BadgeAuditRecord) ProtoMessage() {}
+
+func (x *BadgeAuditRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BadgeAuditRecord.ProtoReflect.Descriptor instead.
+func (*BadgeAuditRecord) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BadgeAuditRecord) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BadgeAuditRecord) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *BadgeAuditRecord) GetBadgeId() string {
+	if x != nil {
+		return x.BadgeId
+	}
+	return ""
+}
+
+func (x *BadgeAuditRecord) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *BadgeAuditRecord) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *BadgeAuditRecord) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *BadgeAuditRecord) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BadgeAuditRecord) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type GetBadgeAuditRecordsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// At least one of player_id and badge_id must be present
+	PlayerId *string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
+	BadgeId  *string `protobuf:"bytes,2,opt,name=badge_id,json=badgeId,proto3,oneof" json:"badge_id,omitempty"`
+	// pageable defaults to the first page of 20 records
+	Pageable *common.Pageable `protobuf:"bytes,3,opt,name=pageable,proto3,oneof" json:"pageable,omitempty"`
+}
+
+func (x *GetBadgeAuditRecordsRequest) Reset() {
+	*x = GetBadgeAuditRecordsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBadgeAuditRecordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBadgeAuditRecordsRequest) ProtoMessage() {}
+
+func (x *GetBadgeAuditRecordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBadgeAuditRecordsRequest.ProtoReflect.Descriptor instead.
+func (*GetBadgeAuditRecordsRequest) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetBadgeAuditRecordsRequest) GetPlayerId() string {
+	if x != nil && x.PlayerId != nil {
+		return *x.PlayerId
+	}
+	return ""
+}
+
+func (x *GetBadgeAuditRecordsRequest) GetBadgeId() string {
+	if x != nil && x.BadgeId != nil {
+		return *x.BadgeId
+	}
+	return ""
+}
+
+func (x *GetBadgeAuditRecordsRequest) GetPageable() *common.Pageable {
+	if x != nil {
+		return x.Pageable
+	}
+	return nil
+}
+
+type GetBadgeAuditRecordsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Records  []*BadgeAuditRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	PageData *common.PageData    `protobuf:"bytes,2,opt,name=page_data,json=pageData,proto3" json:"page_data,omitempty"`
+}
+
+func (x *GetBadgeAuditRecordsResponse) Reset() {
+	*x = GetBadgeAuditRecordsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBadgeAuditRecordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBadgeAuditRecordsResponse) ProtoMessage() {}
+
+func (x *GetBadgeAuditRecordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBadgeAuditRecordsResponse.ProtoReflect.Descriptor instead.
+func (*GetBadgeAuditRecordsResponse) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetBadgeAuditRecordsResponse) GetRecords() []*BadgeAuditRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *GetBadgeAuditRecordsResponse) GetPageData() *common.PageData {
+	if x != nil {
+		return x.PageData
+	}
+	return nil
+}
+
+type BadgeStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BadgeId string `protobuf:"bytes,1,opt,name=badge_id,json=badgeId,proto3" json:"badge_id,omitempty"`
+	Owners  uint64 `protobuf:"varint,2,opt,name=owners,proto3" json:"owners,omitempty"`
+	// rarity is the percentage of all players that own the badge, from 0 to 100
+	Rarity float64 `protobuf:"fixed64,3,opt,name=rarity,proto3" json:"rarity,omitempty"`
+}
+
+func (x *BadgeStats) Reset() {
+	*x = BadgeStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BadgeStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BadgeStats) ProtoMessage() {}
+
+func (x *BadgeStats) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BadgeStats.ProtoReflect.Descriptor instead.
+func (*BadgeStats) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BadgeStats) GetBadgeId() string {
+	if x != nil {
+		return x.BadgeId
+	}
+	return ""
+}
+
+func (x *BadgeStats) GetOwners() uint64 {
+	if x != nil {
+		return x.Owners
+	}
+	return 0
+}
+
+func (x *BadgeStats) GetRarity() float64 {
+	if x != nil {
+		return x.Rarity
+	}
+	return 0
+}
+
+type GetBadgeStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetBadgeStatsRequest) Reset() {
+	*x = GetBadgeStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBadgeStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBadgeStatsRequest) ProtoMessage() {}
+
+func (x *GetBadgeStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBadgeStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetBadgeStatsRequest) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{13}
+}
+
+type GetBadgeStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Badges       []*BadgeStats          `protobuf:"bytes,1,rep,name=badges,proto3" json:"badges,omitempty"`
+	TotalPlayers uint64                 `protobuf:"varint,2,opt,name=total_players,json=totalPlayers,proto3" json:"total_players,omitempty"`
+	ComputedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`
+}
+
+func (x *GetBadgeStatsResponse) Reset() {
+	*x = GetBadgeStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBadgeStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBadgeStatsResponse) ProtoMessage() {}
+
+func (x *GetBadgeStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBadgeStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetBadgeStatsResponse) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetBadgeStatsResponse) GetBadges() []*BadgeStats {
+	if x != nil {
+		return x.Badges
+	}
+	return nil
+}
+
+func (x *GetBadgeStatsResponse) GetTotalPlayers() uint64 {
+	if x != nil {
+		return x.TotalPlayers
+	}
+	return 0
+}
+
+func (x *GetBadgeStatsResponse) GetComputedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return nil
+}
+
+// BadgeGroup badges in a group are tiers of each other. A player only keeps the highest tier they are given.
+type BadgeGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FriendlyName string `protobuf:"bytes,2,opt,name=friendly_name,json=friendlyName,proto3" json:"friendly_name,omitempty"`
+	// badge_ids lowest tier first
+	BadgeIds []string `protobuf:"bytes,3,rep,name=badge_ids,json=badgeIds,proto3" json:"badge_ids,omitempty"`
+}
+
+func (x *BadgeGroup) Reset() {
+	*x = BadgeGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BadgeGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BadgeGroup) ProtoMessage() {}
+
+func (x *BadgeGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BadgeGroup.ProtoReflect.Descriptor instead.
+func (*BadgeGroup) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BadgeGroup) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BadgeGroup) GetFriendlyName() string {
+	if x != nil {
+		return x.FriendlyName
+	}
+	return ""
+}
+
+func (x *BadgeGroup) GetBadgeIds() []string {
+	if x != nil {
+		return x.BadgeIds
+	}
+	return nil
+}
+
+type GetBadgeGroupsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetBadgeGroupsRequest) Reset() {
+	*x = GetBadgeGroupsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBadgeGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBadgeGroupsRequest) ProtoMessage() {}
+
+func (x *GetBadgeGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBadgeGroupsRequest.ProtoReflect.Descriptor instead.
+func (*GetBadgeGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{16}
+}
+
+type GetBadgeGroupsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groups []*BadgeGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *GetBadgeGroupsResponse) Reset() {
+	*x = GetBadgeGroupsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBadgeGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBadgeGroupsResponse) ProtoMessage() {}
+
+func (x *GetBadgeGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBadgeGroupsResponse.ProtoReflect.Descriptor instead.
+func (*GetBadgeGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetBadgeGroupsResponse) GetGroups() []*BadgeGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type BadgeDefinition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Priority     int64  `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+	Required     bool   `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	FriendlyName string `protobuf:"bytes,4,opt,name=friendly_name,json=friendlyName,proto3" json:"friendly_name,omitempty"`
+	ChatString   string `protobuf:"bytes,5,opt,name=chat_string,json=chatString,proto3" json:"chat_string,omitempty"`
+	// hover_text lines, parsed on the client side as MiniMessage
+	HoverText       []string              `protobuf:"bytes,6,rep,name=hover_text,json=hoverText,proto3" json:"hover_text,omitempty"`
+	GuiItem         *BadgeGuiItem         `protobuf:"bytes,7,opt,name=gui_item,json=guiItem,proto3" json:"gui_item,omitempty"`
+	AutomaticGrants *BadgeAutomaticGrants `protobuf:"bytes,8,opt,name=automatic_grants,json=automaticGrants,proto3,oneof" json:"automatic_grants,omitempty"`
+	// archived is ignored by CreateBadge and UpdateBadge
+	Archived bool `protobuf:"varint,9,opt,name=archived,proto3" json:"archived,omitempty"`
+	// forced badges are active whenever they're owned, overriding the badge the player chose
+	Forced bool `protobuf:"varint,10,opt,name=forced,proto3" json:"forced,omitempty"`
+	// locales translations of the badge's text keyed by locale, e.g. "de_de"
+	Locales map[string]*BadgeLocale `protobuf:"bytes,11,rep,name=locales,proto3" json:"locales,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *BadgeDefinition) Reset() {
+	*x = BadgeDefinition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BadgeDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BadgeDefinition) ProtoMessage() {}
+
+func (x *BadgeDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BadgeDefinition.ProtoReflect.Descriptor instead.
+func (*BadgeDefinition) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BadgeDefinition) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BadgeDefinition) GetPriority() int64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *BadgeDefinition) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *BadgeDefinition) GetFriendlyName() string {
+	if x != nil {
+		return x.FriendlyName
+	}
+	return ""
+}
+
+func (x *BadgeDefinition) GetChatString() string {
+	if x != nil {
+		return x.ChatString
+	}
+	return ""
+}
+
+func (x *BadgeDefinition) GetHoverText() []string {
+	if x != nil {
+		return x.HoverText
+	}
+	return nil
+}
+
+func (x *BadgeDefinition) GetGuiItem() *BadgeGuiItem {
+	if x != nil {
+		return x.GuiItem
+	}
+	return nil
+}
+
+func (x *BadgeDefinition) GetAutomaticGrants() *BadgeAutomaticGrants {
+	if x != nil {
+		return x.AutomaticGrants
+	}
+	return nil
+}
+
+func (x *BadgeDefinition) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *BadgeDefinition) GetForced() bool {
+	if x != nil {
+		return x.Forced
+	}
+	return false
+}
+
+func (x *BadgeDefinition) GetLocales() map[string]*BadgeLocale {
+	if x != nil {
+		return x.Locales
+	}
+	return nil
+}
+
+// BadgeLocale text that isn't translated falls back to the default locale
+type BadgeLocale struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FriendlyName   string   `protobuf:"bytes,1,opt,name=friendly_name,json=friendlyName,proto3" json:"friendly_name,omitempty"`
+	HoverText      []string `protobuf:"bytes,2,rep,name=hover_text,json=hoverText,proto3" json:"hover_text,omitempty"`
+	GuiDisplayName string   `protobuf:"bytes,3,opt,name=gui_display_name,json=guiDisplayName,proto3" json:"gui_display_name,omitempty"`
+	GuiLore        []string `protobuf:"bytes,4,rep,name=gui_lore,json=guiLore,proto3" json:"gui_lore,omitempty"`
+}
+
+func (x *BadgeLocale) Reset() {
+	*x = BadgeLocale{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BadgeLocale) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BadgeLocale) ProtoMessage() {}
+
+func (x *BadgeLocale) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BadgeLocale.ProtoReflect.Descriptor instead.
+func (*BadgeLocale) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BadgeLocale) GetFriendlyName() string {
+	if x != nil {
+		return x.FriendlyName
+	}
+	return ""
+}
+
+func (x *BadgeLocale) GetHoverText() []string {
+	if x != nil {
+		return x.HoverText
+	}
+	return nil
+}
+
+func (x *BadgeLocale) GetGuiDisplayName() string {
+	if x != nil {
+		return x.GuiDisplayName
+	}
+	return ""
+}
+
+func (x *BadgeLocale) GetGuiLore() []string {
+	if x != nil {
+		return x.GuiLore
+	}
+	return nil
+}
+
+type BadgeGuiItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// display whether the item is displayed in the GUI if the player doesn't own the badge
+	Display     bool     `protobuf:"varint,1,opt,name=display,proto3" json:"display,omitempty"`
+	Material    string   `protobuf:"bytes,2,opt,name=material,proto3" json:"material,omitempty"`
+	DisplayName string   `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Lore        []string `protobuf:"bytes,4,rep,name=lore,proto3" json:"lore,omitempty"`
+}
+
+func (x *BadgeGuiItem) Reset() {
+	*x = BadgeGuiItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BadgeGuiItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BadgeGuiItem) ProtoMessage() {}
+
+func (x *BadgeGuiItem) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BadgeGuiItem.ProtoReflect.Descriptor instead.
+func (*BadgeGuiItem) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BadgeGuiItem) GetDisplay() bool {
+	if x != nil {
+		return x.Display
+	}
+	return false
+}
+
+func (x *BadgeGuiItem) GetMaterial() string {
+	if x != nil {
+		return x.Material
+	}
+	return ""
+}
+
+func (x *BadgeGuiItem) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *BadgeGuiItem) GetLore() []string {
+	if x != nil {
+		return x.Lore
+	}
+	return nil
+}
+
+type BadgeAutomaticGrants struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GithubPullRequests *int32   `protobuf:"varint,1,opt,name=github_pull_requests,json=githubPullRequests,proto3,oneof" json:"github_pull_requests,omitempty"`
+	PermissionRoles    []string `protobuf:"bytes,2,rep,name=permission_roles,json=permissionRoles,proto3" json:"permission_roles,omitempty"`
+	// permission_role_match is either "any" (the default) or "all"
+	PermissionRoleMatch string         `protobuf:"bytes,3,opt,name=permission_role_match,json=permissionRoleMatch,proto3" json:"permission_role_match,omitempty"`
+	Criteria            *BadgeCriteria `protobuf:"bytes,4,opt,name=criteria,proto3,oneof" json:"criteria,omitempty"`
+}
+
+func (x *BadgeAutomaticGrants) Reset() {
+	*x = BadgeAutomaticGrants{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BadgeAutomaticGrants) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BadgeAutomaticGrants) ProtoMessage() {}
+
+func (x *BadgeAutomaticGrants) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BadgeAutomaticGrants.ProtoReflect.Descriptor instead.
+func (*BadgeAutomaticGrants) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BadgeAutomaticGrants) GetGithubPullRequests() int32 {
+	if x != nil && x.GithubPullRequests != nil {
+		return *x.GithubPullRequests
+	}
+	return 0
+}
+
+func (x *BadgeAutomaticGrants) GetPermissionRoles() []string {
+	if x != nil {
+		return x.PermissionRoles
+	}
+	return nil
+}
+
+func (x *BadgeAutomaticGrants) GetPermissionRoleMatch() string {
+	if x != nil {
+		return x.PermissionRoleMatch
+	}
+	return ""
+}
+
+func (x *BadgeAutomaticGrants) GetCriteria() *BadgeCriteria {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+type BadgeCriteria struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinLevel         *int32                 `protobuf:"varint,1,opt,name=min_level,json=minLevel,proto3,oneof" json:"min_level,omitempty"`
+	MinPlaytime      *durationpb.Duration   `protobuf:"bytes,2,opt,name=min_playtime,json=minPlaytime,proto3,oneof" json:"min_playtime,omitempty"`
+	FirstLoginBefore *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=first_login_before,json=firstLoginBefore,proto3,oneof" json:"first_login_before,omitempty"`
+	MinLoginStreak   *int32                 `protobuf:"varint,4,opt,name=min_login_streak,json=minLoginStreak,proto3,oneof" json:"min_login_streak,omitempty"`
+	OwnsBadges       []string               `protobuf:"bytes,5,rep,name=owns_badges,json=ownsBadges,proto3" json:"owns_badges,omitempty"`
+	Revoke           bool                   `protobuf:"varint,6,opt,name=revoke,proto3" json:"revoke,omitempty"`
+}
+
+func (x *BadgeCriteria) Reset() {
+	*x = BadgeCriteria{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BadgeCriteria) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BadgeCriteria) ProtoMessage() {}
+
+func (x *BadgeCriteria) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BadgeCriteria.ProtoReflect.Descriptor instead.
+func (*BadgeCriteria) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BadgeCriteria) GetMinLevel() int32 {
+	if x != nil && x.MinLevel != nil {
+		return *x.MinLevel
+	}
+	return 0
+}
+
+func (x *BadgeCriteria) GetMinPlaytime() *durationpb.Duration {
+	if x != nil {
+		return x.MinPlaytime
+	}
+	return nil
+}
+
+func (x *BadgeCriteria) GetFirstLoginBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstLoginBefore
+	}
+	return nil
+}
+
+func (x *BadgeCriteria) GetMinLoginStreak() int32 {
+	if x != nil && x.MinLoginStreak != nil {
+		return *x.MinLoginStreak
+	}
+	return 0
+}
+
+func (x *BadgeCriteria) GetOwnsBadges() []string {
+	if x != nil {
+		return x.OwnsBadges
+	}
+	return nil
+}
+
+func (x *BadgeCriteria) GetRevoke() bool {
+	if x != nil {
+		return x.Revoke
+	}
+	return false
+}
+
+type GetBadgeDefinitionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IncludeArchived bool `protobuf:"varint,1,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+}
+
+func (x *GetBadgeDefinitionsRequest) Reset() {
+	*x = GetBadgeDefinitionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBadgeDefinitionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBadgeDefinitionsRequest) ProtoMessage() {}
+
+func (x *GetBadgeDefinitionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBadgeDefinitionsRequest.ProtoReflect.Descriptor instead.
+func (*GetBadgeDefinitionsRequest) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetBadgeDefinitionsRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type GetBadgeDefinitionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Badges []*BadgeDefinition `protobuf:"bytes,1,rep,name=badges,proto3" json:"badges,omitempty"`
+}
+
+func (x *GetBadgeDefinitionsResponse) Reset() {
+	*x = GetBadgeDefinitionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBadgeDefinitionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBadgeDefinitionsResponse) ProtoMessage() {}
+
+func (x *GetBadgeDefinitionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBadgeDefinitionsResponse.ProtoReflect.Descriptor instead.
+func (*GetBadgeDefinitionsResponse) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetBadgeDefinitionsResponse) GetBadges() []*BadgeDefinition {
+	if x != nil {
+		return x.Badges
+	}
+	return nil
+}
+
+type CreateBadgeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Badge *BadgeDefinition `protobuf:"bytes,1,opt,name=badge,proto3" json:"badge,omitempty"`
+}
+
+func (x *CreateBadgeRequest) Reset() {
+	*x = CreateBadgeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBadgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBadgeRequest) ProtoMessage() {}
+
+func (x *CreateBadgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBadgeRequest.ProtoReflect.Descriptor instead.
+func (*CreateBadgeRequest) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreateBadgeRequest) GetBadge() *BadgeDefinition {
+	if x != nil {
+		return x.Badge
+	}
+	return nil
+}
+
+type CreateBadgeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CreateBadgeResponse) Reset() {
+	*x = CreateBadgeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBadgeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBadgeResponse) ProtoMessage() {}
+
+func (x *CreateBadgeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBadgeResponse.ProtoReflect.Descriptor instead.
+func (*CreateBadgeResponse) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{26}
+}
+
+type UpdateBadgeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Badge *BadgeDefinition `protobuf:"bytes,1,opt,name=badge,proto3" json:"badge,omitempty"`
+}
+
+func (x *UpdateBadgeRequest) Reset() {
+	*x = UpdateBadgeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBadgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBadgeRequest) ProtoMessage() {}
+
+func (x *UpdateBadgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBadgeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBadgeRequest) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UpdateBadgeRequest) GetBadge() *BadgeDefinition {
+	if x != nil {
+		return x.Badge
+	}
+	return nil
+}
+
+type UpdateBadgeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateBadgeResponse) Reset() {
+	*x = UpdateBadgeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBadgeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBadgeResponse) ProtoMessage() {}
+
+func (x *UpdateBadgeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBadgeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBadgeResponse) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{28}
+}
+
+type SetBadgeArchivedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BadgeId  string `protobuf:"bytes,1,opt,name=badge_id,json=badgeId,proto3" json:"badge_id,omitempty"`
+	Archived bool   `protobuf:"varint,2,opt,name=archived,proto3" json:"archived,omitempty"`
+}
+
+func (x *SetBadgeArchivedRequest) Reset() {
+	*x = SetBadgeArchivedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetBadgeArchivedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBadgeArchivedRequest) ProtoMessage() {}
+
+func (x *SetBadgeArchivedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBadgeArchivedRequest.ProtoReflect.Descriptor instead.
+func (*SetBadgeArchivedRequest) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetBadgeArchivedRequest) GetBadgeId() string {
+	if x != nil {
+		return x.BadgeId
+	}
+	return ""
+}
+
+func (x *SetBadgeArchivedRequest) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+type SetBadgeArchivedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetBadgeArchivedResponse) Reset() {
+	*x = SetBadgeArchivedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetBadgeArchivedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBadgeArchivedResponse) ProtoMessage() {}
+
+func (x *SetBadgeArchivedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBadgeArchivedResponse.ProtoReflect.Descriptor instead.
+func (*SetBadgeArchivedResponse) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{30}
+}
+
+type ReorderBadgesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// badge_ids highest priority first
+	BadgeIds []string `protobuf:"bytes,1,rep,name=badge_ids,json=badgeIds,proto3" json:"badge_ids,omitempty"`
+}
+
+func (x *ReorderBadgesRequest) Reset() {
+	*x = ReorderBadgesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReorderBadgesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderBadgesRequest) ProtoMessage() {}
+
+func (x *ReorderBadgesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderBadgesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderBadgesRequest) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ReorderBadgesRequest) GetBadgeIds() []string {
+	if x != nil {
+		return x.BadgeIds
+	}
+	return nil
+}
+
+type ReorderBadgesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReorderBadgesResponse) Reset() {
+	*x = ReorderBadgesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReorderBadgesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderBadgesResponse) ProtoMessage() {}
+
+func (x *ReorderBadgesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderBadgesResponse.ProtoReflect.Descriptor instead.
+func (*ReorderBadgesResponse) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{32}
+}
+
+// BadgeJobPlayerFilter matches players that meet every condition that is present
+type BadgeJobPlayerFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FirstLoginAfter  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=first_login_after,json=firstLoginAfter,proto3,oneof" json:"first_login_after,omitempty"`
+	FirstLoginBefore *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=first_login_before,json=firstLoginBefore,proto3,oneof" json:"first_login_before,omitempty"`
+	MinLevel         *int32                 `protobuf:"varint,3,opt,name=min_level,json=minLevel,proto3,oneof" json:"min_level,omitempty"`
+	MinPlaytime      *durationpb.Duration   `protobuf:"bytes,4,opt,name=min_playtime,json=minPlaytime,proto3,oneof" json:"min_playtime,omitempty"`
+}
+
+func (x *BadgeJobPlayerFilter) Reset() {
+	*x = BadgeJobPlayerFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BadgeJobPlayerFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BadgeJobPlayerFilter) ProtoMessage() {}
+
+func (x *BadgeJobPlayerFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BadgeJobPlayerFilter.ProtoReflect.Descriptor instead.
+func (*BadgeJobPlayerFilter) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *BadgeJobPlayerFilter) GetFirstLoginAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstLoginAfter
+	}
+	return nil
+}
+
+func (x *BadgeJobPlayerFilter) GetFirstLoginBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstLoginBefore
+	}
+	return nil
+}
+
+func (x *BadgeJobPlayerFilter) GetMinLevel() int32 {
+	if x != nil && x.MinLevel != nil {
+		return *x.MinLevel
+	}
+	return 0
+}
+
+func (x *BadgeJobPlayerFilter) GetMinPlaytime() *durationpb.Duration {
+	if x != nil {
+		return x.MinPlaytime
+	}
+	return nil
+}
+
+type BadgeJobPlayerList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerIds []string `protobuf:"bytes,1,rep,name=player_ids,json=playerIds,proto3" json:"player_ids,omitempty"`
+}
+
+func (x *BadgeJobPlayerList) Reset() {
+	*x = BadgeJobPlayerList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BadgeJobPlayerList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BadgeJobPlayerList) ProtoMessage() {}
+
+func (x *BadgeJobPlayerList) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BadgeJobPlayerList.ProtoReflect.Descriptor instead.
+func (*BadgeJobPlayerList) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *BadgeJobPlayerList) GetPlayerIds() []string {
+	if x != nil {
+		return x.PlayerIds
+	}
+	return nil
+}
+
+type BadgeJob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// action is either grant or revoke
+	Action  string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	BadgeId string `protobuf:"bytes,3,opt,name=badge_id,json=badgeId,proto3" json:"badge_id,omitempty"`
+	// expires_at when granted badges expire, not present if they are permanent
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	// player_filter is present if the job isn't for a list of players
+	PlayerFilter *BadgeJobPlayerFilter `protobuf:"bytes,5,opt,name=player_filter,json=playerFilter,proto3,oneof" json:"player_filter,omitempty"`
+	// status is one of pending, running, completed, cancelled or failed
+	Status string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	// error why the job failed
+	Error *string `protobuf:"bytes,7,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	// total the number of players matched when the job was created
+	Total     uint64 `protobuf:"varint,8,opt,name=total,proto3" json:"total,omitempty"`
+	Processed uint64 `protobuf:"varint,9,opt,name=processed,proto3" json:"processed,omitempty"`
+	// changed the number of players that were granted or revoked the badge
+	Changed uint64 `protobuf:"varint,10,opt,name=changed,proto3" json:"changed,omitempty"`
+	// skipped the number of players that already had (or didn't have) the badge
+	Skipped     uint64                 `protobuf:"varint,11,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Failed      uint64                 `protobuf:"varint,12,opt,name=failed,proto3" json:"failed,omitempty"`
+	Actor       string                 `protobuf:"bytes,13,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason      string                 `protobuf:"bytes,14,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CompletedAt *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=completed_at,json=completedAt,proto3,oneof" json:"completed_at,omitempty"`
+}
+
+func (x *BadgeJob) Reset() {
+	*x = BadgeJob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BadgeJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BadgeJob) ProtoMessage() {}
+
+func (x *BadgeJob) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BadgeJob.ProtoReflect.Descriptor instead.
+func (*BadgeJob) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *BadgeJob) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BadgeJob) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *BadgeJob) GetBadgeId() string {
+	if x != nil {
+		return x.BadgeId
+	}
+	return ""
+}
+
+func (x *BadgeJob) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *BadgeJob) GetPlayerFilter() *BadgeJobPlayerFilter {
+	if x != nil {
+		return x.PlayerFilter
+	}
+	return nil
+}
+
+func (x *BadgeJob) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BadgeJob) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *BadgeJob) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *BadgeJob) GetProcessed() uint64 {
+	if x != nil {
+		return x.Processed
+	}
+	return 0
+}
+
+func (x *BadgeJob) GetChanged() uint64 {
+	if x != nil {
+		return x.Changed
+	}
+	return 0
+}
+
+func (x *BadgeJob) GetSkipped() uint64 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *BadgeJob) GetFailed() uint64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *BadgeJob) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *BadgeJob) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BadgeJob) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *BadgeJob) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *BadgeJob) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+type CreateBadgeJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// action is either grant or revoke
+	Action  string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	BadgeId string `protobuf:"bytes,2,opt,name=badge_id,json=badgeId,proto3" json:"badge_id,omitempty"`
+	// expires_at if present, granted badges are temporary. Must not be present when revoking.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	// Types that are assignable to Players:
+	//	*CreateBadgeJobRequest_PlayerList
+	//	*CreateBadgeJobRequest_PlayerFilter
+	Players isCreateBadgeJobRequest_Players `protobuf_oneof:"players"`
+}
+
+func (x *CreateBadgeJobRequest) Reset() {
+	*x = CreateBadgeJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBadgeJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBadgeJobRequest) ProtoMessage() {}
+
+func (x *CreateBadgeJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBadgeJobRequest.ProtoReflect.Descriptor instead.
+func (*CreateBadgeJobRequest) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateBadgeJobRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *CreateBadgeJobRequest) GetBadgeId() string {
+	if x != nil {
+		return x.BadgeId
+	}
+	return ""
+}
+
+func (x *CreateBadgeJobRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (m *CreateBadgeJobRequest) GetPlayers() isCreateBadgeJobRequest_Players {
+	if m != nil {
+		return m.Players
+	}
+	return nil
+}
+
+func (x *CreateBadgeJobRequest) GetPlayerList() *BadgeJobPlayerList {
+	if x, ok := x.GetPlayers().(*CreateBadgeJobRequest_PlayerList); ok {
+		return x.PlayerList
+	}
+	return nil
+}
+
+func (x *CreateBadgeJobRequest) GetPlayerFilter() *BadgeJobPlayerFilter {
+	if x, ok := x.GetPlayers().(*CreateBadgeJobRequest_PlayerFilter); ok {
+		return x.PlayerFilter
+	}
+	return nil
+}
+
+type isCreateBadgeJobRequest_Players interface {
+	isCreateBadgeJobRequest_Players()
+}
+
+type CreateBadgeJobRequest_PlayerList struct {
+	PlayerList *BadgeJobPlayerList `protobuf:"bytes,4,opt,name=player_list,json=playerList,proto3,oneof"`
+}
+
+type CreateBadgeJobRequest_PlayerFilter struct {
+	PlayerFilter *BadgeJobPlayerFilter `protobuf:"bytes,5,opt,name=player_filter,json=playerFilter,proto3,oneof"`
+}
+
+func (*CreateBadgeJobRequest_PlayerList) isCreateBadgeJobRequest_Players() {}
+
+func (*CreateBadgeJobRequest_PlayerFilter) isCreateBadgeJobRequest_Players() {}
+
+type CreateBadgeJobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Job *BadgeJob `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+}
+
+func (x *CreateBadgeJobResponse) Reset() {
+	*x = CreateBadgeJobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBadgeJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBadgeJobResponse) ProtoMessage() {}
+
+func (x *CreateBadgeJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBadgeJobResponse.ProtoReflect.Descriptor instead.
+func (*CreateBadgeJobResponse) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CreateBadgeJobResponse) GetJob() *BadgeJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type GetBadgeJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+}
+
+func (x *GetBadgeJobRequest) Reset() {
+	*x = GetBadgeJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBadgeJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBadgeJobRequest) ProtoMessage() {}
+
+func (x *GetBadgeJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBadgeJobRequest.ProtoReflect.Descriptor instead.
+func (*GetBadgeJobRequest) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetBadgeJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type GetBadgeJobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Job *BadgeJob `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+}
+
+func (x *GetBadgeJobResponse) Reset() {
+	*x = GetBadgeJobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBadgeJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBadgeJobResponse) ProtoMessage() {}
+
+func (x *GetBadgeJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBadgeJobResponse.ProtoReflect.Descriptor instead.
+func (*GetBadgeJobResponse) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetBadgeJobResponse) GetJob() *BadgeJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type GetBadgeJobsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pageable defaults to the first page of 20 jobs
+	Pageable *common.Pageable `protobuf:"bytes,1,opt,name=pageable,proto3,oneof" json:"pageable,omitempty"`
+}
+
+func (x *GetBadgeJobsRequest) Reset() {
+	*x = GetBadgeJobsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBadgeJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBadgeJobsRequest) ProtoMessage() {}
+
+func (x *GetBadgeJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBadgeJobsRequest.ProtoReflect.Descriptor instead.
+func (*GetBadgeJobsRequest) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetBadgeJobsRequest) GetPageable() *common.Pageable {
+	if x != nil {
+		return x.Pageable
+	}
+	return nil
+}
+
+type GetBadgeJobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jobs     []*BadgeJob      `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	PageData *common.PageData `protobuf:"bytes,2,opt,name=page_data,json=pageData,proto3" json:"page_data,omitempty"`
+}
+
+func (x *GetBadgeJobsResponse) Reset() {
+	*x = GetBadgeJobsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBadgeJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBadgeJobsResponse) ProtoMessage() {}
+
+func (x *GetBadgeJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBadgeJobsResponse.ProtoReflect.Descriptor instead.
+func (*GetBadgeJobsResponse) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetBadgeJobsResponse) GetJobs() []*BadgeJob {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+func (x *GetBadgeJobsResponse) GetPageData() *common.PageData {
+	if x != nil {
+		return x.PageData
+	}
+	return nil
+}
+
+type CancelBadgeJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+}
+
+func (x *CancelBadgeJobRequest) Reset() {
+	*x = CancelBadgeJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelBadgeJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBadgeJobRequest) ProtoMessage() {}
+
+func (x *CancelBadgeJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBadgeJobRequest.ProtoReflect.Descriptor instead.
+func (*CancelBadgeJobRequest) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CancelBadgeJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type CancelBadgeJobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelBadgeJobResponse) Reset() {
+	*x = CancelBadgeJobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_grpc_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelBadgeJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBadgeJobResponse) ProtoMessage() {}
+
+func (x *CancelBadgeJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_grpc_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBadgeJobResponse.ProtoReflect.Descriptor instead.
+func (*CancelBadgeJobResponse) Descriptor() ([]byte, []int) {
+	return file_badge_grpc_proto_rawDescGZIP(), []int{43}
+}
+
+var File_badge_grpc_proto protoreflect.FileDescriptor
+
+var file_badge_grpc_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x62, 0x61, 0x64, 0x67, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x17, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x7e, 0x0a, 0x1d, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x69,
+	0x74, 0x48, 0x75, 0x62, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x2c, 0x0a, 0x0f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a,
+	0x10, 0x5f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x20, 0x0a, 0x1e, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x69,
+	0x74, 0x48, 0x75, 0x62, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xc5, 0x01, 0x0a, 0x0a, 0x42, 0x61, 0x64, 0x67, 0x65, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x3e, 0x0a,
+	0x0a, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52,
+	0x09, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52,
+	0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x22, 0x95, 0x01, 0x0a, 0x20,
+	0x41, 0x64, 0x64, 0x54, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x42, 0x61, 0x64, 0x67,
+	0x65, 0x54, 0x6f, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x62, 0x61, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x62, 0x61, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x41, 0x74, 0x22, 0x23, 0x0a, 0x21, 0x41, 0x64, 0x64, 0x54, 0x65, 0x6d, 0x70, 0x6f, 0x72,
+	0x61, 0x72, 0x79, 0x42, 0x61, 0x64, 0x67, 0x65, 0x54, 0x6f, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x42, 0x61, 0x64, 0x67, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x5b, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x42, 0x61, 0x64, 0x67, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x42,
+	0x61, 0x64, 0x67, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x73, 0x22, 0x3c, 0x0a, 0x1d, 0x52, 0x65, 0x73, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x42, 0x61, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x20, 0x0a, 0x1e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x42, 0x61, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0xf2, 0x01, 0x0a, 0x10, 0x42, 0x61, 0x64, 0x67, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xc1, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x42, 0x61,
+	0x64, 0x67, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x62, 0x61, 0x64, 0x67,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x62, 0x61,
+	0x64, 0x67, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6d, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x61,
+	0x62, 0x6c, 0x65, 0x48, 0x02, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x88,
+	0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x99, 0x01, 0x0a, 0x1c, 0x47,
+	0x65, 0x74, 0x42, 0x61, 0x64, 0x67, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x07, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65,
+	0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x62, 0x61, 0x64, 0x67,
+	0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x42, 0x61, 0x64, 0x67, 0x65, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x12, 0x34, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x57, 0x0a, 0x0a, 0x42, 0x61, 0x64, 0x67, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x22,
+	0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x61, 0x64, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xb6, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x42,
+	0x61, 0x64, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3b, 0x0a, 0x06, 0x62, 0x61, 0x64, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x42, 0x61, 0x64, 0x67,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x06, 0x62, 0x61, 0x64, 0x67, 0x65, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x5e, 0x0a, 0x0a, 0x42, 0x61, 0x64, 0x67, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x62, 0x61, 0x64, 0x67, 0x65, 0x49, 0x64, 0x73,
+	0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x42, 0x61, 0x64, 0x67, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x55, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x42, 0x61, 0x64, 0x67, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x42, 0x61,
+	0x64, 0x67, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x22, 0xdb, 0x04, 0x0a, 0x0f, 0x42, 0x61, 0x64, 0x67, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d,
+	0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x74, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x68, 0x6f, 0x76, 0x65, 0x72, 0x54, 0x65, 0x78,
+	0x74, 0x12, 0x40, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x42, 0x61,
+	0x64, 0x67, 0x65, 0x47, 0x75, 0x69, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x07, 0x67, 0x75, 0x69, 0x49,
+	0x74, 0x65, 0x6d, 0x12, 0x5d, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63,
+	0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
+	0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x62, 0x61, 0x64,
+	0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x42, 0x61, 0x64, 0x67, 0x65, 0x41, 0x75, 0x74,
+	0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x48, 0x00, 0x52, 0x0f,
+	0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x64, 0x12, 0x4f, 0x0a, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65,
+	0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61,
+	0x6c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x42, 0x61, 0x64, 0x67, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x73, 0x1a, 0x60, 0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3a, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74,
+	0x61, 0x6c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x42, 0x61, 0x64, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x61, 0x75,
+	0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x96,
+	0x01, 0x0a, 0x0b, 0x42, 0x61, 0x64, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x68, 0x6f, 0x76, 0x65, 0x72, 0x54, 0x65,
+	0x78, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x67, 0x75, 0x69, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x67, 0x75,
+	0x69, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x67, 0x75, 0x69, 0x5f, 0x6c, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x67, 0x75, 0x69, 0x4c, 0x6f, 0x72, 0x65, 0x22, 0x7b, 0x0a, 0x0c, 0x42, 0x61, 0x64, 0x67, 0x65,
+	0x47, 0x75, 0x69, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x21, 0x0a,
+	0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x6c, 0x6f, 0x72, 0x65, 0x22, 0x9b, 0x02, 0x0a, 0x14, 0x42, 0x61, 0x64, 0x67, 0x65, 0x41, 0x75,
+	0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x35, 0x0a,
+	0x14, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x5f, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x12, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f,
+	0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12,
+	0x32, 0x0a, 0x15, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x6f,
+	0x6c, 0x65, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13,
+	0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x47, 0x0a, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x42, 0x61, 0x64, 0x67, 0x65, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x48, 0x01, 0x52,
+	0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x88, 0x01, 0x01, 0x42, 0x17, 0x0a, 0x15,
+	0x5f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x5f, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72,
+	0x69, 0x61, 0x22, 0xf6, 0x02, 0x0a, 0x0d, 0x42, 0x61, 0x64, 0x67, 0x65, 0x43, 0x72, 0x69, 0x74,
+	0x65, 0x72, 0x69, 0x61, 0x12, 0x20, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x41, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x6c,
+	0x61, 0x79, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x50, 0x6c,
+	0x61, 0x79, 0x74, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x4d, 0x0a, 0x12, 0x66, 0x69, 0x72,
+	0x73, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x48, 0x02, 0x52, 0x10, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x5f,
+	0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x03, 0x52, 0x0e, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x77, 0x6e, 0x73, 0x5f,
+	0x62, 0x61, 0x64, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x77,
+	0x6e, 0x73, 0x42, 0x61, 0x64, 0x67, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x42, 0x0f,
+	0x0a, 0x0d, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x74, 0x69, 0x6d, 0x65, 0x42,
+	0x15, 0x0a, 0x13, 0x5f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f,
+	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x6c,
+	0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x22, 0x47, 0x0a, 0x1a, 0x47,
+	0x65, 0x74, 0x42, 0x61, 0x64, 0x67, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x64, 0x22, 0x5f, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x42, 0x61, 0x64, 0x67, 0x65,
+	0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x06, 0x62, 0x61, 0x64, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x42, 0x61,
+	0x64, 0x67, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x62,
+	0x61, 0x64, 0x67, 0x65, 0x73, 0x22, 0x54, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42,
+	0x61, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x05, 0x62,
+	0x61, 0x64, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x65, 0x6d, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x42, 0x61, 0x64, 0x67, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x62, 0x61, 0x64, 0x67, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x54, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x64, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x05, 0x62, 0x61, 0x64, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61,
+	0x6c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x42, 0x61, 0x64, 0x67, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x05, 0x62, 0x61, 0x64, 0x67, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x42, 0x61, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x50, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x42, 0x61, 0x64, 0x67, 0x65, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61,
+	0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61,
+	0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x42, 0x61, 0x64, 0x67, 0x65, 0x41, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x0a,
+	0x14, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x61, 0x64, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x64, 0x67, 0x65, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x62, 0x61, 0x64, 0x67, 0x65, 0x49,
+	0x64, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x61, 0x64,
+	0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe3, 0x02, 0x0a, 0x14,
+	0x42, 0x61, 0x64, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x11, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6c, 0x6f,
+	0x67, 0x69, 0x6e, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0f, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x66, 0x74, 0x65, 0x72, 0x88, 0x01,
+	0x01, 0x12, 0x4d, 0x0a, 0x12, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x10, 0x66, 0x69, 0x72,
+	0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x20, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88,
+	0x01, 0x01, 0x12, 0x41, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x03, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x74, 0x69,
+	0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f,
+	0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x42, 0x15, 0x0a, 0x13, 0x5f,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x74, 0x69, 0x6d,
+	0x65, 0x22, 0x33, 0x0a, 0x12, 0x42, 0x61, 0x64, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0xbd, 0x05, 0x0a, 0x08, 0x42, 0x61, 0x64, 0x67, 0x65,
+	0x4a, 0x6f, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x62,
+	0x61, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62,
+	0x61, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x57, 0x0a, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
+	0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x62, 0x61, 0x64,
+	0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x42, 0x61, 0x64, 0x67, 0x65, 0x4a, 0x6f, 0x62,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x48, 0x01, 0x52, 0x0c,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x88,
+	0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x42, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x03, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xca, 0x02, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x42, 0x61, 0x64, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x64, 0x67,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x64, 0x67,
+	0x65, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x4e, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74,
+	0x61, 0x6c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x42, 0x61, 0x64, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x54, 0x0a, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x65, 0x6d, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x42, 0x61, 0x64, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0c, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x61, 0x74, 0x22, 0x4d, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x64,
+	0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a,
+	0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x6d, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x42, 0x61, 0x64, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a,
+	0x6f, 0x62, 0x22, 0x2b, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x61, 0x64, 0x67, 0x65, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22,
+	0x4a, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x42, 0x61, 0x64, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x42, 0x61,
+	0x64, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22, 0x5c, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x42, 0x61, 0x64, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x38, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x00, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x42, 0x61, 0x64, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x35, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x42, 0x61, 0x64, 0x67, 0x65,
+	0x4a, 0x6f, 0x62, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65,
+	0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x67,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22,
+	0x2e, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x64, 0x67, 0x65, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22,
+	0x18, 0x0a, 0x16, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x64, 0x67, 0x65, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xa5, 0x0f, 0x0a, 0x0a, 0x42, 0x61,
+	0x64, 0x67, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x89, 0x01, 0x0a, 0x16, 0x53, 0x65, 0x74,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x36, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65,
+	0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x65, 0x6d,
+	0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x62, 0x61, 0x64, 0x67, 0x65,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47,
+	0x69, 0x74, 0x48, 0x75, 0x62, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x92, 0x01, 0x0a, 0x19, 0x41, 0x64, 0x64, 0x54, 0x65, 0x6d, 0x70,
+	0x6f, 0x72, 0x61, 0x72, 0x79, 0x42, 0x61, 0x64, 0x67, 0x65, 0x54, 0x6f, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x12, 0x39, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64,
+	0x54, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x42, 0x61, 0x64, 0x67, 0x65, 0x54, 0x6f,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e,
+	0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x62, 0x61, 0x64,
+	0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x65, 0x6d, 0x70, 0x6f,
+	0x72, 0x61, 0x72, 0x79, 0x42, 0x61, 0x64, 0x67, 0x65, 0x54, 0x6f, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x83, 0x01, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x42, 0x61, 0x64, 0x67, 0x65, 0x47, 0x72, 0x61, 0x6e,
+	0x74, 0x73, 0x12, 0x34, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x42, 0x61, 0x64, 0x67, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74,
+	0x61, 0x6c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x42, 0x61, 0x64, 0x67,
+	0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x89, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x42, 0x61, 0x64, 0x67, 0x65, 0x12, 0x36, 0x2e, 0x65, 0x6d, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x42, 0x61, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x37, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x42, 0x61,
+	0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x83, 0x01, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x42, 0x61, 0x64, 0x67, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x12, 0x34, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47,
+	0x65, 0x74, 0x42, 0x61, 0x64, 0x67, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x65, 0x6d, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x64, 0x67, 0x65, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x6e, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x42, 0x61, 0x64, 0x67, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x2d, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74,
+	0x42, 0x61, 0x64, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2e, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x42,
+	0x61, 0x64, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x71, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x64, 0x67, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x12, 0x2e, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65,
+	0x74, 0x42, 0x61, 0x64, 0x67, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65,
+	0x74, 0x42, 0x61, 0x64, 0x67, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x42, 0x61, 0x64, 0x67,
+	0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x33, 0x2e, 0x65,
+	0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x62, 0x61, 0x64, 0x67,
+	0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x64, 0x67, 0x65, 0x44,
+	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x34, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x42,
+	0x61, 0x64, 0x67, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x42, 0x61, 0x64, 0x67, 0x65, 0x12, 0x2b, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x68, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x64, 0x67, 0x65,
+	0x12, 0x2b, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x42, 0x61, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
+	0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x62, 0x61, 0x64,
+	0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61,
+	0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x10, 0x53,
+	0x65, 0x74, 0x42, 0x61, 0x64, 0x67, 0x65, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12,
+	0x30, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x62,
+	0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x61, 0x64,
+	0x67, 0x65, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x31, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x74, 0x42,
+	0x61, 0x64, 0x67, 0x65, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x0d, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42,
+	0x61, 0x64, 0x67, 0x65, 0x73, 0x12, 0x2d, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x61, 0x64, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x52,
+	0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x61, 0x64, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61,
+	0x64, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x2e, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x64, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x64, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x42, 0x61,
+	0x64, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x2b, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x64, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65,
+	0x74, 0x42, 0x61, 0x64, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x6b, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x42, 0x61, 0x64, 0x67, 0x65, 0x4a, 0x6f, 0x62,
+	0x73, 0x12, 0x2c, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x42,
+	0x61, 0x64, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2d, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x62,
+	0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x64,
+	0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71,
+	0x0a, 0x0e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x64, 0x67, 0x65, 0x4a, 0x6f, 0x62,
+	0x12, 0x2e, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x42, 0x61, 0x64, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2f, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x42, 0x61, 0x64, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x42, 0x2a, 0x5a, 0x28, 0x6d, 0x63, 0x2d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2d, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x67, 0x72,
+	0x70, 0x63, 0x2f, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_badge_grpc_proto_rawDescOnce sync.Once
+	file_badge_grpc_proto_rawDescData = file_badge_grpc_proto_rawDesc
+)
+
+func file_badge_grpc_proto_rawDescGZIP() []byte {
+	file_badge_grpc_proto_rawDescOnce.Do(func() {
+		file_badge_grpc_proto_rawDescData = protoimpl.X.CompressGZIP(file_badge_grpc_proto_rawDescData)
+	})
+	return file_badge_grpc_proto_rawDescData
+}
+
+var file_badge_grpc_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
+var file_badge_grpc_proto_goTypes = []interface{}{
+	(*SetPlayerGitHubAccountRequest)(nil),     // 0: emortal.grpc.badgeadmin.SetPlayerGitHubAccountRequest
+	(*SetPlayerGitHubAccountResponse)(nil),    // 1: emortal.grpc.badgeadmin.SetPlayerGitHubAccountResponse
+	(*BadgeGrant)(nil),                        // 2: emortal.grpc.badgeadmin.BadgeGrant
+	(*AddTemporaryBadgeToPlayerRequest)(nil),  // 3: emortal.grpc.badgeadmin.AddTemporaryBadgeToPlayerRequest
+	(*AddTemporaryBadgeToPlayerResponse)(nil), // 4: emortal.grpc.badgeadmin.AddTemporaryBadgeToPlayerResponse
+	(*GetPlayerBadgeGrantsRequest)(nil),       // 5: emortal.grpc.badgeadmin.GetPlayerBadgeGrantsRequest
+	(*GetPlayerBadgeGrantsResponse)(nil),      // 6: emortal.grpc.badgeadmin.GetPlayerBadgeGrantsResponse
+	(*ResetActivePlayerBadgeRequest)(nil),     // 7: emortal.grpc.badgeadmin.ResetActivePlayerBadgeRequest
+	(*ResetActivePlayerBadgeResponse)(nil),    // 8: emortal.grpc.badgeadmin.ResetActivePlayerBadgeResponse
+	(*BadgeAuditRecord)(nil),                  // 9: emortal.grpc.badgeadmin.BadgeAuditRecord
+	(*GetBadgeAuditRecordsRequest)(nil),       // 10: emortal.grpc.badgeadmin.GetBadgeAuditRecordsRequest
+	(*GetBadgeAuditRecordsResponse)(nil),      // 11: emortal.grpc.badgeadmin.GetBadgeAuditRecordsResponse
+	(*BadgeStats)(nil),                        // 12: emortal.grpc.badgeadmin.BadgeStats
+	(*GetBadgeStatsRequest)(nil),              // 13: emortal.grpc.badgeadmin.GetBadgeStatsRequest
+	(*GetBadgeStatsResponse)(nil),             // 14: emortal.grpc.badgeadmin.GetBadgeStatsResponse
+	(*BadgeGroup)(nil),                        // 15: emortal.grpc.badgeadmin.BadgeGroup
+	(*GetBadgeGroupsRequest)(nil),             // 16: emortal.grpc.badgeadmin.GetBadgeGroupsRequest
+	(*GetBadgeGroupsResponse)(nil),            // 17: emortal.grpc.badgeadmin.GetBadgeGroupsResponse
+	(*BadgeDefinition)(nil),                   // 18: emortal.grpc.badgeadmin.BadgeDefinition
+	(*BadgeLocale)(nil),                       // 19: emortal.grpc.badgeadmin.BadgeLocale
+	(*BadgeGuiItem)(nil),                      // 20: emortal.grpc.badgeadmin.BadgeGuiItem
+	(*BadgeAutomaticGrants)(nil),              // 21: emortal.grpc.badgeadmin.BadgeAutomaticGrants
+	(*BadgeCriteria)(nil),                     // 22: emortal.grpc.badgeadmin.BadgeCriteria
+	(*GetBadgeDefinitionsRequest)(nil),        // 23: emortal.grpc.badgeadmin.GetBadgeDefinitionsRequest
+	(*GetBadgeDefinitionsResponse)(nil),       // 24: emortal.grpc.badgeadmin.GetBadgeDefinitionsResponse
+	(*CreateBadgeRequest)(nil),                // 25: emortal.grpc.badgeadmin.CreateBadgeRequest
+	(*CreateBadgeResponse)(nil),               // 26: emortal.grpc.badgeadmin.CreateBadgeResponse
+	(*UpdateBadgeRequest)(nil),                // 27: emortal.grpc.badgeadmin.UpdateBadgeRequest
+	(*UpdateBadgeResponse)(nil),               // 28: emortal.grpc.badgeadmin.UpdateBadgeResponse
+	(*SetBadgeArchivedRequest)(nil),           // 29: emortal.grpc.badgeadmin.SetBadgeArchivedRequest
+	(*SetBadgeArchivedResponse)(nil),          // 30: emortal.grpc.badgeadmin.SetBadgeArchivedResponse
+	(*ReorderBadgesRequest)(nil),              // 31: emortal.grpc.badgeadmin.ReorderBadgesRequest
+	(*ReorderBadgesResponse)(nil),             // 32: emortal.grpc.badgeadmin.ReorderBadgesResponse
+	(*BadgeJobPlayerFilter)(nil),              // 33: emortal.grpc.badgeadmin.BadgeJobPlayerFilter
+	(*BadgeJobPlayerList)(nil),                // 34: emortal.grpc.badgeadmin.BadgeJobPlayerList
+	(*BadgeJob)(nil),                          // 35: emortal.grpc.badgeadmin.BadgeJob
+	(*CreateBadgeJobRequest)(nil),             // 36: emortal.grpc.badgeadmin.CreateBadgeJobRequest
+	(*CreateBadgeJobResponse)(nil),            // 37: emortal.grpc.badgeadmin.CreateBadgeJobResponse
+	(*GetBadgeJobRequest)(nil),                // 38: emortal.grpc.badgeadmin.GetBadgeJobRequest
+	(*GetBadgeJobResponse)(nil),               // 39: emortal.grpc.badgeadmin.GetBadgeJobResponse
+	(*GetBadgeJobsRequest)(nil),               // 40: emortal.grpc.badgeadmin.GetBadgeJobsRequest
+	(*GetBadgeJobsResponse)(nil),              // 41: emortal.grpc.badgeadmin.GetBadgeJobsResponse
+	(*CancelBadgeJobRequest)(nil),             // 42: emortal.grpc.badgeadmin.CancelBadgeJobRequest
+	(*CancelBadgeJobResponse)(nil),            // 43: emortal.grpc.badgeadmin.CancelBadgeJobResponse
+	nil,                                       // 44: emortal.grpc.badgeadmin.BadgeDefinition.LocalesEntry
+	(*timestamppb.Timestamp)(nil),             // 45: google.protobuf.Timestamp
+	(*common.Pageable)(nil),                   // 46: emortal.model.Pageable
+	(*common.PageData)(nil),                   // 47: emortal.model.PageData
+	(*durationpb.Duration)(nil),               // 48: google.protobuf.Duration
+}
+var file_badge_grpc_proto_depIdxs = []int32{
+	45, // 0: emortal.grpc.badgeadmin.BadgeGrant.granted_at:type_name -> google.protobuf.Timestamp
+	45, // 1: emortal.grpc.badgeadmin.BadgeGrant.expires_at:type_name -> google.protobuf.Timestamp
+	45, // 2: emortal.grpc.badgeadmin.AddTemporaryBadgeToPlayerRequest.expires_at:type_name -> google.protobuf.Timestamp
+	2,  // 3: emortal.grpc.badgeadmin.GetPlayerBadgeGrantsResponse.grants:type_name -> emortal.grpc.badgeadmin.BadgeGrant
+	45, // 4: emortal.grpc.badgeadmin.BadgeAuditRecord.timestamp:type_name -> google.protobuf.Timestamp
+	46, // 5: emortal.grpc.badgeadmin.GetBadgeAuditRecordsRequest.pageable:type_name -> emortal.model.Pageable
+	9,  // 6: emortal.grpc.badgeadmin.GetBadgeAuditRecordsResponse.records:type_name -> emortal.grpc.badgeadmin.BadgeAuditRecord
+	47, // 7: emortal.grpc.badgeadmin.GetBadgeAuditRecordsResponse.page_data:type_name -> emortal.model.PageData
+	12, // 8: emortal.grpc.badgeadmin.GetBadgeStatsResponse.badges:type_name -> emortal.grpc.badgeadmin.BadgeStats
+	45, // 9: emortal.grpc.badgeadmin.GetBadgeStatsResponse.computed_at:type_name -> google.protobuf.Timestamp
+	15, // 10: emortal.grpc.badgeadmin.GetBadgeGroupsResponse.groups:type_name -> emortal.grpc.badgeadmin.BadgeGroup
+	20, // 11: emortal.grpc.badgeadmin.BadgeDefinition.gui_item:type_name -> emortal.grpc.badgeadmin.BadgeGuiItem
+	21, // 12: emortal.grpc.badgeadmin.BadgeDefinition.automatic_grants:type_name -> emortal.grpc.badgeadmin.BadgeAutomaticGrants
+	44, // 13: emortal.grpc.badgeadmin.BadgeDefinition.locales:type_name -> emortal.grpc.badgeadmin.BadgeDefinition.LocalesEntry
+	22, // 14: emortal.grpc.badgeadmin.BadgeAutomaticGrants.criteria:type_name -> emortal.grpc.badgeadmin.BadgeCriteria
+	48, // 15: emortal.grpc.badgeadmin.BadgeCriteria.min_playtime:type_name -> google.protobuf.Duration
+	45, // 16: emortal.grpc.badgeadmin.BadgeCriteria.first_login_before:type_name -> google.protobuf.Timestamp
+	18, // 17: emortal.grpc.badgeadmin.GetBadgeDefinitionsResponse.badges:type_name -> emortal.grpc.badgeadmin.BadgeDefinition
+	18, // 18: emortal.grpc.badgeadmin.CreateBadgeRequest.badge:type_name -> emortal.grpc.badgeadmin.BadgeDefinition
+	18, // 19: emortal.grpc.badgeadmin.UpdateBadgeRequest.badge:type_name -> emortal.grpc.badgeadmin.BadgeDefinition
+	45, // 20: emortal.grpc.badgeadmin.BadgeJobPlayerFilter.first_login_after:type_name -> google.protobuf.Timestamp
+	45, // 21: emortal.grpc.badgeadmin.BadgeJobPlayerFilter.first_login_before:type_name -> google.protobuf.Timestamp
+	48, // 22: emortal.grpc.badgeadmin.BadgeJobPlayerFilter.min_playtime:type_name -> google.protobuf.Duration
+	45, // 23: emortal.grpc.badgeadmin.BadgeJob.expires_at:type_name -> google.protobuf.Timestamp
+	33, // 24: emortal.grpc.badgeadmin.BadgeJob.player_filter:type_name -> emortal.grpc.badgeadmin.BadgeJobPlayerFilter
+	45, // 25: emortal.grpc.badgeadmin.BadgeJob.created_at:type_name -> google.protobuf.Timestamp
+	45, // 26: emortal.grpc.badgeadmin.BadgeJob.updated_at:type_name -> google.protobuf.Timestamp
+	45, // 27: emortal.grpc.badgeadmin.BadgeJob.completed_at:type_name -> google.protobuf.Timestamp
+	45, // 28: emortal.grpc.badgeadmin.CreateBadgeJobRequest.expires_at:type_name -> google.protobuf.Timestamp
+	34, // 29: emortal.grpc.badgeadmin.CreateBadgeJobRequest.player_list:type_name -> emortal.grpc.badgeadmin.BadgeJobPlayerList
+	33, // 30: emortal.grpc.badgeadmin.CreateBadgeJobRequest.player_filter:type_name -> emortal.grpc.badgeadmin.BadgeJobPlayerFilter
+	35, // 31: emortal.grpc.badgeadmin.CreateBadgeJobResponse.job:type_name -> emortal.grpc.badgeadmin.BadgeJob
+	35, // 32: emortal.grpc.badgeadmin.GetBadgeJobResponse.job:type_name -> emortal.grpc.badgeadmin.BadgeJob
+	46, // 33: emortal.grpc.badgeadmin.GetBadgeJobsRequest.pageable:type_name -> emortal.model.Pageable
+	35, // 34: emortal.grpc.badgeadmin.GetBadgeJobsResponse.jobs:type_name -> emortal.grpc.badgeadmin.BadgeJob
+	47, // 35: emortal.grpc.badgeadmin.GetBadgeJobsResponse.page_data:type_name -> emortal.model.PageData
+	19, // 36: emortal.grpc.badgeadmin.BadgeDefinition.LocalesEntry.value:type_name -> emortal.grpc.badgeadmin.BadgeLocale
+	0,  // 37: emortal.grpc.badgeadmin.BadgeAdmin.SetPlayerGitHubAccount:input_type -> emortal.grpc.badgeadmin.SetPlayerGitHubAccountRequest
+	3,  // 38: emortal.grpc.badgeadmin.BadgeAdmin.AddTemporaryBadgeToPlayer:input_type -> emortal.grpc.badgeadmin.AddTemporaryBadgeToPlayerRequest
+	5,  // 39: emortal.grpc.badgeadmin.BadgeAdmin.GetPlayerBadgeGrants:input_type -> emortal.grpc.badgeadmin.GetPlayerBadgeGrantsRequest
+	7,  // 40: emortal.grpc.badgeadmin.BadgeAdmin.ResetActivePlayerBadge:input_type -> emortal.grpc.badgeadmin.ResetActivePlayerBadgeRequest
+	10, // 41: emortal.grpc.badgeadmin.BadgeAdmin.GetBadgeAuditRecords:input_type -> emortal.grpc.badgeadmin.GetBadgeAuditRecordsRequest
+	13, // 42: emortal.grpc.badgeadmin.BadgeAdmin.GetBadgeStats:input_type -> emortal.grpc.badgeadmin.GetBadgeStatsRequest
+	16, // 43: emortal.grpc.badgeadmin.BadgeAdmin.GetBadgeGroups:input_type -> emortal.grpc.badgeadmin.GetBadgeGroupsRequest
+	23, // 44: emortal.grpc.badgeadmin.BadgeAdmin.GetBadgeDefinitions:input_type -> emortal.grpc.badgeadmin.GetBadgeDefinitionsRequest
+	25, // 45: emortal.grpc.badgeadmin.BadgeAdmin.CreateBadge:input_type -> emortal.grpc.badgeadmin.CreateBadgeRequest
+	27, // 46: emortal.grpc.badgeadmin.BadgeAdmin.UpdateBadge:input_type -> emortal.grpc.badgeadmin.UpdateBadgeRequest
+	29, // 47: emortal.grpc.badgeadmin.BadgeAdmin.SetBadgeArchived:input_type -> emortal.grpc.badgeadmin.SetBadgeArchivedRequest
+	31, // 48: emortal.grpc.badgeadmin.BadgeAdmin.ReorderBadges:input_type -> emortal.grpc.badgeadmin.ReorderBadgesRequest
+	36, // 49: emortal.grpc.badgeadmin.BadgeAdmin.CreateBadgeJob:input_type -> emortal.grpc.badgeadmin.CreateBadgeJobRequest
+	38, // 50: emortal.grpc.badgeadmin.BadgeAdmin.GetBadgeJob:input_type -> emortal.grpc.badgeadmin.GetBadgeJobRequest
+	40, // 51: emortal.grpc.badgeadmin.BadgeAdmin.GetBadgeJobs:input_type -> emortal.grpc.badgeadmin.GetBadgeJobsRequest
+	42, // 52: emortal.grpc.badgeadmin.BadgeAdmin.CancelBadgeJob:input_type -> emortal.grpc.badgeadmin.CancelBadgeJobRequest
+	1,  // 53: emortal.grpc.badgeadmin.BadgeAdmin.SetPlayerGitHubAccount:output_type -> emortal.grpc.badgeadmin.SetPlayerGitHubAccountResponse
+	4,  // 54: emortal.grpc.badgeadmin.BadgeAdmin.AddTemporaryBadgeToPlayer:output_type -> emortal.grpc.badgeadmin.AddTemporaryBadgeToPlayerResponse
+	6,  // 55: emortal.grpc.badgeadmin.BadgeAdmin.GetPlayerBadgeGrants:output_type -> emortal.grpc.badgeadmin.GetPlayerBadgeGrantsResponse
+	8,  // 56: emortal.grpc.badgeadmin.BadgeAdmin.ResetActivePlayerBadge:output_type -> emortal.grpc.badgeadmin.ResetActivePlayerBadgeResponse
+	11, // 57: emortal.grpc.badgeadmin.BadgeAdmin.GetBadgeAuditRecords:output_type -> emortal.grpc.badgeadmin.GetBadgeAuditRecordsResponse
+	14, // 58: emortal.grpc.badgeadmin.BadgeAdmin.GetBadgeStats:output_type -> emortal.grpc.badgeadmin.GetBadgeStatsResponse
+	17, // 59: emortal.grpc.badgeadmin.BadgeAdmin.GetBadgeGroups:output_type -> emortal.grpc.badgeadmin.GetBadgeGroupsResponse
+	24, // 60: emortal.grpc.badgeadmin.BadgeAdmin.GetBadgeDefinitions:output_type -> emortal.grpc.badgeadmin.GetBadgeDefinitionsResponse
+	26, // 61: emortal.grpc.badgeadmin.BadgeAdmin.CreateBadge:output_type -> emortal.grpc.badgeadmin.CreateBadgeResponse
+	28, // 62: emortal.grpc.badgeadmin.BadgeAdmin.UpdateBadge:output_type -> emortal.grpc.badgeadmin.UpdateBadgeResponse
+	30, // 63: emortal.grpc.badgeadmin.BadgeAdmin.SetBadgeArchived:output_type -> emortal.grpc.badgeadmin.SetBadgeArchivedResponse
+	32, // 64: emortal.grpc.badgeadmin.BadgeAdmin.ReorderBadges:output_type -> emortal.grpc.badgeadmin.ReorderBadgesResponse
+	37, // 65: emortal.grpc.badgeadmin.BadgeAdmin.CreateBadgeJob:output_type -> emortal.grpc.badgeadmin.CreateBadgeJobResponse
+	39, // 66: emortal.grpc.badgeadmin.BadgeAdmin.GetBadgeJob:output_type -> emortal.grpc.badgeadmin.GetBadgeJobResponse
+	41, // 67: emortal.grpc.badgeadmin.BadgeAdmin.GetBadgeJobs:output_type -> emortal.grpc.badgeadmin.GetBadgeJobsResponse
+	43, // 68: emortal.grpc.badgeadmin.BadgeAdmin.CancelBadgeJob:output_type -> emortal.grpc.badgeadmin.CancelBadgeJobResponse
+	53, // [53:69] is the sub-list for method output_type
+	37, // [37:53] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
+}
+
+func init() { file_badge_grpc_proto_init() }
+func file_badge_grpc_proto_init() {
+	if File_badge_grpc_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_badge_grpc_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPlayerGitHubAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPlayerGitHubAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BadgeGrant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddTemporaryBadgeToPlayerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddTemporaryBadgeToPlayerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlayerBadgeGrantsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlayerBadgeGrantsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetActivePlayerBadgeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetActivePlayerBadgeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BadgeAuditRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBadgeAuditRecordsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBadgeAuditRecordsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BadgeStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBadgeStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBadgeStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BadgeGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBadgeGroupsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBadgeGroupsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BadgeDefinition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BadgeLocale); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BadgeGuiItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BadgeAutomaticGrants); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BadgeCriteria); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBadgeDefinitionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBadgeDefinitionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBadgeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBadgeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBadgeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBadgeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetBadgeArchivedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetBadgeArchivedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReorderBadgesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReorderBadgesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BadgeJobPlayerFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BadgeJobPlayerList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BadgeJob); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBadgeJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBadgeJobResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBadgeJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBadgeJobResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBadgeJobsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBadgeJobsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelBadgeJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_badge_grpc_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelBadgeJobResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_badge_grpc_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_badge_grpc_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_badge_grpc_proto_msgTypes[10].OneofWrappers = []interface{}{}
+	file_badge_grpc_proto_msgTypes[18].OneofWrappers = []interface{}{}
+	file_badge_grpc_proto_msgTypes[21].OneofWrappers = []interface{}{}
+	file_badge_grpc_proto_msgTypes[22].OneofWrappers = []interface{}{}
+	file_badge_grpc_proto_msgTypes[33].OneofWrappers = []interface{}{}
+	file_badge_grpc_proto_msgTypes[35].OneofWrappers = []interface{}{}
+	file_badge_grpc_proto_msgTypes[36].OneofWrappers = []interface{}{
+		(*CreateBadgeJobRequest_PlayerList)(nil),
+		(*CreateBadgeJobRequest_PlayerFilter)(nil),
+	}
+	file_badge_grpc_proto_msgTypes[40].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_badge_grpc_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   45,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_badge_grpc_proto_goTypes,
+		DependencyIndexes: file_badge_grpc_proto_depIdxs,
+		MessageInfos:      file_badge_grpc_proto_msgTypes,
+	}.Build()
+	File_badge_grpc_proto = out.File
+	file_badge_grpc_proto_rawDesc = nil
+	file_badge_grpc_proto_goTypes = nil
+	file_badge_grpc_proto_depIdxs = nil
+}
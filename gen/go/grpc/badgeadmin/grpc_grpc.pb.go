@@ -0,0 +1,697 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v4.24.4
+// source: badge/grpc.proto
+
+package badgeadmin
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// BadgeAdminClient is the client API for BadgeAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BadgeAdminClient interface {
+	// SetPlayerGitHubAccount links a GitHub account to a player so they are granted contributor badges
+	// for their merged pull requests. The account is unlinked if github_username is not present.
+	SetPlayerGitHubAccount(ctx context.Context, in *SetPlayerGitHubAccountRequest, opts ...grpc.CallOption) (*SetPlayerGitHubAccountResponse, error)
+	// AddTemporaryBadgeToPlayer functions like BadgeManager#AddBadgeToPlayer but the badge is removed once it expires
+	AddTemporaryBadgeToPlayer(ctx context.Context, in *AddTemporaryBadgeToPlayerRequest, opts ...grpc.CallOption) (*AddTemporaryBadgeToPlayerResponse, error)
+	// GetPlayerBadgeGrants returns when each of the player's badges was granted and when it expires.
+	// BadgeManager#GetPlayerBadges can't return this as its messages are defined in proto-specs,
+	// so clients that show expiry times call this alongside it.
+	GetPlayerBadgeGrants(ctx context.Context, in *GetPlayerBadgeGrantsRequest, opts ...grpc.CallOption) (*GetPlayerBadgeGrantsResponse, error)
+	// ResetActivePlayerBadge forgets the badge the player chose with BadgeManager#SetActivePlayerBadge,
+	// so their active badge is calculated automatically again
+	ResetActivePlayerBadge(ctx context.Context, in *ResetActivePlayerBadgeRequest, opts ...grpc.CallOption) (*ResetActivePlayerBadgeResponse, error)
+	// GetBadgeAuditRecords returns who granted, revoked or activated badges, newest first.
+	//
+	// Changes made through BadgeManager and BadgeAdmin record the "actor" and "reason" metadata of the request.
+	GetBadgeAuditRecords(ctx context.Context, in *GetBadgeAuditRecordsRequest, opts ...grpc.CallOption) (*GetBadgeAuditRecordsResponse, error)
+	// GetBadgeStats returns how many players own each badge. The stats are cached for a few minutes.
+	// UNAVAILABLE is returned while they are computed for the first time.
+	GetBadgeStats(ctx context.Context, in *GetBadgeStatsRequest, opts ...grpc.CallOption) (*GetBadgeStatsResponse, error)
+	// GetBadgeGroups returns every badge group so that a GUI can show the tiers of a group together
+	GetBadgeGroups(ctx context.Context, in *GetBadgeGroupsRequest, opts ...grpc.CallOption) (*GetBadgeGroupsResponse, error)
+	GetBadgeDefinitions(ctx context.Context, in *GetBadgeDefinitionsRequest, opts ...grpc.CallOption) (*GetBadgeDefinitionsResponse, error)
+	// CreateBadge fails with ALREADY_EXISTS if a badge (including an archived one) has the same id
+	CreateBadge(ctx context.Context, in *CreateBadgeRequest, opts ...grpc.CallOption) (*CreateBadgeResponse, error)
+	// UpdateBadge replaces the whole definition of a badge. Whether it's archived is unchanged.
+	UpdateBadge(ctx context.Context, in *UpdateBadgeRequest, opts ...grpc.CallOption) (*UpdateBadgeResponse, error)
+	// SetBadgeArchived archives a badge, removing it from the catalogue, or restores it.
+	// Players keep archived badges but can't display them.
+	SetBadgeArchived(ctx context.Context, in *SetBadgeArchivedRequest, opts ...grpc.CallOption) (*SetBadgeArchivedResponse, error)
+	// ReorderBadges reassigns the priorities the badges already use between them, so that the first badge
+	// has the highest priority. The priorities of other badges are unchanged.
+	ReorderBadges(ctx context.Context, in *ReorderBadgesRequest, opts ...grpc.CallOption) (*ReorderBadgesResponse, error)
+	// CreateBadgeJob starts granting or revoking a badge for many players in the background.
+	// Changes are recorded against the "actor" and "reason" metadata of the request.
+	CreateBadgeJob(ctx context.Context, in *CreateBadgeJobRequest, opts ...grpc.CallOption) (*CreateBadgeJobResponse, error)
+	// GetBadgeJob returns the progress of a job
+	GetBadgeJob(ctx context.Context, in *GetBadgeJobRequest, opts ...grpc.CallOption) (*GetBadgeJobResponse, error)
+	// GetBadgeJobs returns jobs newest first
+	GetBadgeJobs(ctx context.Context, in *GetBadgeJobsRequest, opts ...grpc.CallOption) (*GetBadgeJobsResponse, error)
+	// CancelBadgeJob stops a job after its current batch. Changes already made are kept.
+	// Fails with FAILED_PRECONDITION if the job has finished.
+	CancelBadgeJob(ctx context.Context, in *CancelBadgeJobRequest, opts ...grpc.CallOption) (*CancelBadgeJobResponse, error)
+}
+
+type badgeAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBadgeAdminClient(cc grpc.ClientConnInterface) BadgeAdminClient {
+	return &badgeAdminClient{cc}
+}
+
+func (c *badgeAdminClient) SetPlayerGitHubAccount(ctx context.Context, in *SetPlayerGitHubAccountRequest, opts ...grpc.CallOption) (*SetPlayerGitHubAccountResponse, error) {
+	out := new(SetPlayerGitHubAccountResponse)
+	err := c.cc.Invoke(ctx, "/emortal.grpc.badgeadmin.BadgeAdmin/SetPlayerGitHubAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *badgeAdminClient) AddTemporaryBadgeToPlayer(ctx context.Context, in *AddTemporaryBadgeToPlayerRequest, opts ...grpc.CallOption) (*AddTemporaryBadgeToPlayerResponse, error) {
+	out := new(AddTemporaryBadgeToPlayerResponse)
+	err := c.cc.Invoke(ctx, "/emortal.grpc.badgeadmin.BadgeAdmin/AddTemporaryBadgeToPlayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *badgeAdminClient) GetPlayerBadgeGrants(ctx context.Context, in *GetPlayerBadgeGrantsRequest, opts ...grpc.CallOption) (*GetPlayerBadgeGrantsResponse, error) {
+	out := new(GetPlayerBadgeGrantsResponse)
+	err := c.cc.Invoke(ctx, "/emortal.grpc.badgeadmin.BadgeAdmin/GetPlayerBadgeGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *badgeAdminClient) ResetActivePlayerBadge(ctx context.Context, in *ResetActivePlayerBadgeRequest, opts ...grpc.CallOption) (*ResetActivePlayerBadgeResponse, error) {
+	out := new(ResetActivePlayerBadgeResponse)
+	err := c.cc.Invoke(ctx, "/emortal.grpc.badgeadmin.BadgeAdmin/ResetActivePlayerBadge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *badgeAdminClient) GetBadgeAuditRecords(ctx context.Context, in *GetBadgeAuditRecordsRequest, opts ...grpc.CallOption) (*GetBadgeAuditRecordsResponse, error) {
+	out := new(GetBadgeAuditRecordsResponse)
+	err := c.cc.Invoke(ctx, "/emortal.grpc.badgeadmin.BadgeAdmin/GetBadgeAuditRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *badgeAdminClient) GetBadgeStats(ctx context.Context, in *GetBadgeStatsRequest, opts ...grpc.CallOption) (*GetBadgeStatsResponse, error) {
+	out := new(GetBadgeStatsResponse)
+	err := c.cc.Invoke(ctx, "/emortal.grpc.badgeadmin.BadgeAdmin/GetBadgeStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *badgeAdminClient) GetBadgeGroups(ctx context.Context, in *GetBadgeGroupsRequest, opts ...grpc.CallOption) (*GetBadgeGroupsResponse, error) {
+	out := new(GetBadgeGroupsResponse)
+	err := c.cc.Invoke(ctx, "/emortal.grpc.badgeadmin.BadgeAdmin/GetBadgeGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *badgeAdminClient) GetBadgeDefinitions(ctx context.Context, in *GetBadgeDefinitionsRequest, opts ...grpc.CallOption) (*GetBadgeDefinitionsResponse, error) {
+	out := new(GetBadgeDefinitionsResponse)
+	err := c.cc.Invoke(ctx, "/emortal.grpc.badgeadmin.BadgeAdmin/GetBadgeDefinitions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *badgeAdminClient) CreateBadge(ctx context.Context, in *CreateBadgeRequest, opts ...grpc.CallOption) (*CreateBadgeResponse, error) {
+	out := new(CreateBadgeResponse)
+	err := c.cc.Invoke(ctx, "/emortal.grpc.badgeadmin.BadgeAdmin/CreateBadge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *badgeAdminClient) UpdateBadge(ctx context.Context, in *UpdateBadgeRequest, opts ...grpc.CallOption) (*UpdateBadgeResponse, error) {
+	out := new(UpdateBadgeResponse)
+	err := c.cc.Invoke(ctx, "/emortal.grpc.badgeadmin.BadgeAdmin/UpdateBadge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *badgeAdminClient) SetBadgeArchived(ctx context.Context, in *SetBadgeArchivedRequest, opts ...grpc.CallOption) (*SetBadgeArchivedResponse, error) {
+	out := new(SetBadgeArchivedResponse)
+	err := c.cc.Invoke(ctx, "/emortal.grpc.badgeadmin.BadgeAdmin/SetBadgeArchived", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *badgeAdminClient) ReorderBadges(ctx context.Context, in *ReorderBadgesRequest, opts ...grpc.CallOption) (*ReorderBadgesResponse, error) {
+	out := new(ReorderBadgesResponse)
+	err := c.cc.Invoke(ctx, "/emortal.grpc.badgeadmin.BadgeAdmin/ReorderBadges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *badgeAdminClient) CreateBadgeJob(ctx context.Context, in *CreateBadgeJobRequest, opts ...grpc.CallOption) (*CreateBadgeJobResponse, error) {
+	out := new(CreateBadgeJobResponse)
+	err := c.cc.Invoke(ctx, "/emortal.grpc.badgeadmin.BadgeAdmin/CreateBadgeJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *badgeAdminClient) GetBadgeJob(ctx context.Context, in *GetBadgeJobRequest, opts ...grpc.CallOption) (*GetBadgeJobResponse, error) {
+	out := new(GetBadgeJobResponse)
+	err := c.cc.Invoke(ctx, "/emortal.grpc.badgeadmin.BadgeAdmin/GetBadgeJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *badgeAdminClient) GetBadgeJobs(ctx context.Context, in *GetBadgeJobsRequest, opts ...grpc.CallOption) (*GetBadgeJobsResponse, error) {
+	out := new(GetBadgeJobsResponse)
+	err := c.cc.Invoke(ctx, "/emortal.grpc.badgeadmin.BadgeAdmin/GetBadgeJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *badgeAdminClient) CancelBadgeJob(ctx context.Context, in *CancelBadgeJobRequest, opts ...grpc.CallOption) (*CancelBadgeJobResponse, error) {
+	out := new(CancelBadgeJobResponse)
+	err := c.cc.Invoke(ctx, "/emortal.grpc.badgeadmin.BadgeAdmin/CancelBadgeJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BadgeAdminServer is the server API for BadgeAdmin service.
+// All implementations must embed UnimplementedBadgeAdminServer
+// for forward compatibility
+type BadgeAdminServer interface {
+	// SetPlayerGitHubAccount links a GitHub account to a player so they are granted contributor badges
+	// for their merged pull requests. The account is unlinked if github_username is not present.
+	SetPlayerGitHubAccount(context.Context, *SetPlayerGitHubAccountRequest) (*SetPlayerGitHubAccountResponse, error)
+	// AddTemporaryBadgeToPlayer functions like BadgeManager#AddBadgeToPlayer but the badge is removed once it expires
+	AddTemporaryBadgeToPlayer(context.Context, *AddTemporaryBadgeToPlayerRequest) (*AddTemporaryBadgeToPlayerResponse, error)
+	// GetPlayerBadgeGrants returns when each of the player's badges was granted and when it expires.
+	// BadgeManager#GetPlayerBadges can't return this as its messages are defined in proto-specs,
+	// so clients that show expiry times call this alongside it.
+	GetPlayerBadgeGrants(context.Context, *GetPlayerBadgeGrantsRequest) (*GetPlayerBadgeGrantsResponse, error)
+	// ResetActivePlayerBadge forgets the badge the player chose with BadgeManager#SetActivePlayerBadge,
+	// so their active badge is calculated automatically again
+	ResetActivePlayerBadge(context.Context, *ResetActivePlayerBadgeRequest) (*ResetActivePlayerBadgeResponse, error)
+	// GetBadgeAuditRecords returns who granted, revoked or activated badges, newest first.
+	//
+	// Changes made through BadgeManager and BadgeAdmin record the "actor" and "reason" metadata of the request.
+	GetBadgeAuditRecords(context.Context, *GetBadgeAuditRecordsRequest) (*GetBadgeAuditRecordsResponse, error)
+	// GetBadgeStats returns how many players own each badge. The stats are cached for a few minutes.
+	// UNAVAILABLE is returned while they are computed for the first time.
+	GetBadgeStats(context.Context, *GetBadgeStatsRequest) (*GetBadgeStatsResponse, error)
+	// GetBadgeGroups returns every badge group so that a GUI can show the tiers of a group together
+	GetBadgeGroups(context.Context, *GetBadgeGroupsRequest) (*GetBadgeGroupsResponse, error)
+	GetBadgeDefinitions(context.Context, *GetBadgeDefinitionsRequest) (*GetBadgeDefinitionsResponse, error)
+	// CreateBadge fails with ALREADY_EXISTS if a badge (including an archived one) has the same id
+	CreateBadge(context.Context, *CreateBadgeRequest) (*CreateBadgeResponse, error)
+	// UpdateBadge replaces the whole definition of a badge. Whether it's archived is unchanged.
+	UpdateBadge(context.Context, *UpdateBadgeRequest) (*UpdateBadgeResponse, error)
+	// SetBadgeArchived archives a badge, removing it from the catalogue, or restores it.
+	// Players keep archived badges but can't display them.
+	SetBadgeArchived(context.Context, *SetBadgeArchivedRequest) (*SetBadgeArchivedResponse, error)
+	// ReorderBadges reassigns the priorities the badges already use between them, so that the first badge
+	// has the highest priority. The priorities of other badges are unchanged.
+	ReorderBadges(context.Context, *ReorderBadgesRequest) (*ReorderBadgesResponse, error)
+	// CreateBadgeJob starts granting or revoking a badge for many players in the background.
+	// Changes are recorded against the "actor" and "reason" metadata of the request.
+	CreateBadgeJob(context.Context, *CreateBadgeJobRequest) (*CreateBadgeJobResponse, error)
+	// GetBadgeJob returns the progress of a job
+	GetBadgeJob(context.Context, *GetBadgeJobRequest) (*GetBadgeJobResponse, error)
+	// GetBadgeJobs returns jobs newest first
+	GetBadgeJobs(context.Context, *GetBadgeJobsRequest) (*GetBadgeJobsResponse, error)
+	// CancelBadgeJob stops a job after its current batch. Changes already made are kept.
+	// Fails with FAILED_PRECONDITION if the job has finished.
+	CancelBadgeJob(context.Context, *CancelBadgeJobRequest) (*CancelBadgeJobResponse, error)
+	mustEmbedUnimplementedBadgeAdminServer()
+}
+
+// UnimplementedBadgeAdminServer must be embedded to have forward compatible implementations.
+type UnimplementedBadgeAdminServer struct {
+}
+
+func (UnimplementedBadgeAdminServer) SetPlayerGitHubAccount(context.Context, *SetPlayerGitHubAccountRequest) (*SetPlayerGitHubAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPlayerGitHubAccount not implemented")
+}
+func (UnimplementedBadgeAdminServer) AddTemporaryBadgeToPlayer(context.Context, *AddTemporaryBadgeToPlayerRequest) (*AddTemporaryBadgeToPlayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTemporaryBadgeToPlayer not implemented")
+}
+func (UnimplementedBadgeAdminServer) GetPlayerBadgeGrants(context.Context, *GetPlayerBadgeGrantsRequest) (*GetPlayerBadgeGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerBadgeGrants not implemented")
+}
+func (UnimplementedBadgeAdminServer) ResetActivePlayerBadge(context.Context, *ResetActivePlayerBadgeRequest) (*ResetActivePlayerBadgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetActivePlayerBadge not implemented")
+}
+func (UnimplementedBadgeAdminServer) GetBadgeAuditRecords(context.Context, *GetBadgeAuditRecordsRequest) (*GetBadgeAuditRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBadgeAuditRecords not implemented")
+}
+func (UnimplementedBadgeAdminServer) GetBadgeStats(context.Context, *GetBadgeStatsRequest) (*GetBadgeStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBadgeStats not implemented")
+}
+func (UnimplementedBadgeAdminServer) GetBadgeGroups(context.Context, *GetBadgeGroupsRequest) (*GetBadgeGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBadgeGroups not implemented")
+}
+func (UnimplementedBadgeAdminServer) GetBadgeDefinitions(context.Context, *GetBadgeDefinitionsRequest) (*GetBadgeDefinitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBadgeDefinitions not implemented")
+}
+func (UnimplementedBadgeAdminServer) CreateBadge(context.Context, *CreateBadgeRequest) (*CreateBadgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBadge not implemented")
+}
+func (UnimplementedBadgeAdminServer) UpdateBadge(context.Context, *UpdateBadgeRequest) (*UpdateBadgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBadge not implemented")
+}
+func (UnimplementedBadgeAdminServer) SetBadgeArchived(context.Context, *SetBadgeArchivedRequest) (*SetBadgeArchivedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBadgeArchived not implemented")
+}
+func (UnimplementedBadgeAdminServer) ReorderBadges(context.Context, *ReorderBadgesRequest) (*ReorderBadgesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderBadges not implemented")
+}
+func (UnimplementedBadgeAdminServer) CreateBadgeJob(context.Context, *CreateBadgeJobRequest) (*CreateBadgeJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBadgeJob not implemented")
+}
+func (UnimplementedBadgeAdminServer) GetBadgeJob(context.Context, *GetBadgeJobRequest) (*GetBadgeJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBadgeJob not implemented")
+}
+func (UnimplementedBadgeAdminServer) GetBadgeJobs(context.Context, *GetBadgeJobsRequest) (*GetBadgeJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBadgeJobs not implemented")
+}
+func (UnimplementedBadgeAdminServer) CancelBadgeJob(context.Context, *CancelBadgeJobRequest) (*CancelBadgeJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBadgeJob not implemented")
+}
+func (UnimplementedBadgeAdminServer) mustEmbedUnimplementedBadgeAdminServer() {}
+
+// UnsafeBadgeAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BadgeAdminServer will
+// result in compilation errors.
+type UnsafeBadgeAdminServer interface {
+	mustEmbedUnimplementedBadgeAdminServer()
+}
+
+func RegisterBadgeAdminServer(s grpc.ServiceRegistrar, srv BadgeAdminServer) {
+	s.RegisterService(&BadgeAdmin_ServiceDesc, srv)
+}
+
+func _BadgeAdmin_SetPlayerGitHubAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPlayerGitHubAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BadgeAdminServer).SetPlayerGitHubAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.grpc.badgeadmin.BadgeAdmin/SetPlayerGitHubAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BadgeAdminServer).SetPlayerGitHubAccount(ctx, req.(*SetPlayerGitHubAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BadgeAdmin_AddTemporaryBadgeToPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTemporaryBadgeToPlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BadgeAdminServer).AddTemporaryBadgeToPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.grpc.badgeadmin.BadgeAdmin/AddTemporaryBadgeToPlayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BadgeAdminServer).AddTemporaryBadgeToPlayer(ctx, req.(*AddTemporaryBadgeToPlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BadgeAdmin_GetPlayerBadgeGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerBadgeGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BadgeAdminServer).GetPlayerBadgeGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.grpc.badgeadmin.BadgeAdmin/GetPlayerBadgeGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BadgeAdminServer).GetPlayerBadgeGrants(ctx, req.(*GetPlayerBadgeGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BadgeAdmin_ResetActivePlayerBadge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetActivePlayerBadgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BadgeAdminServer).ResetActivePlayerBadge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.grpc.badgeadmin.BadgeAdmin/ResetActivePlayerBadge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BadgeAdminServer).ResetActivePlayerBadge(ctx, req.(*ResetActivePlayerBadgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BadgeAdmin_GetBadgeAuditRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBadgeAuditRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BadgeAdminServer).GetBadgeAuditRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.grpc.badgeadmin.BadgeAdmin/GetBadgeAuditRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BadgeAdminServer).GetBadgeAuditRecords(ctx, req.(*GetBadgeAuditRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BadgeAdmin_GetBadgeStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBadgeStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BadgeAdminServer).GetBadgeStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.grpc.badgeadmin.BadgeAdmin/GetBadgeStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BadgeAdminServer).GetBadgeStats(ctx, req.(*GetBadgeStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BadgeAdmin_GetBadgeGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBadgeGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BadgeAdminServer).GetBadgeGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.grpc.badgeadmin.BadgeAdmin/GetBadgeGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BadgeAdminServer).GetBadgeGroups(ctx, req.(*GetBadgeGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BadgeAdmin_GetBadgeDefinitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBadgeDefinitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BadgeAdminServer).GetBadgeDefinitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.grpc.badgeadmin.BadgeAdmin/GetBadgeDefinitions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BadgeAdminServer).GetBadgeDefinitions(ctx, req.(*GetBadgeDefinitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BadgeAdmin_CreateBadge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBadgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BadgeAdminServer).CreateBadge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.grpc.badgeadmin.BadgeAdmin/CreateBadge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BadgeAdminServer).CreateBadge(ctx, req.(*CreateBadgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BadgeAdmin_UpdateBadge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBadgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BadgeAdminServer).UpdateBadge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.grpc.badgeadmin.BadgeAdmin/UpdateBadge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BadgeAdminServer).UpdateBadge(ctx, req.(*UpdateBadgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BadgeAdmin_SetBadgeArchived_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBadgeArchivedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BadgeAdminServer).SetBadgeArchived(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.grpc.badgeadmin.BadgeAdmin/SetBadgeArchived",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BadgeAdminServer).SetBadgeArchived(ctx, req.(*SetBadgeArchivedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BadgeAdmin_ReorderBadges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderBadgesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BadgeAdminServer).ReorderBadges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.grpc.badgeadmin.BadgeAdmin/ReorderBadges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BadgeAdminServer).ReorderBadges(ctx, req.(*ReorderBadgesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BadgeAdmin_CreateBadgeJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBadgeJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BadgeAdminServer).CreateBadgeJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.grpc.badgeadmin.BadgeAdmin/CreateBadgeJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BadgeAdminServer).CreateBadgeJob(ctx, req.(*CreateBadgeJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BadgeAdmin_GetBadgeJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBadgeJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BadgeAdminServer).GetBadgeJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.grpc.badgeadmin.BadgeAdmin/GetBadgeJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BadgeAdminServer).GetBadgeJob(ctx, req.(*GetBadgeJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BadgeAdmin_GetBadgeJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBadgeJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BadgeAdminServer).GetBadgeJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.grpc.badgeadmin.BadgeAdmin/GetBadgeJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BadgeAdminServer).GetBadgeJobs(ctx, req.(*GetBadgeJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BadgeAdmin_CancelBadgeJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBadgeJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BadgeAdminServer).CancelBadgeJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.grpc.badgeadmin.BadgeAdmin/CancelBadgeJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BadgeAdminServer).CancelBadgeJob(ctx, req.(*CancelBadgeJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BadgeAdmin_ServiceDesc is the grpc.ServiceDesc for BadgeAdmin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BadgeAdmin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "emortal.grpc.badgeadmin.BadgeAdmin",
+	HandlerType: (*BadgeAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetPlayerGitHubAccount",
+			Handler:    _BadgeAdmin_SetPlayerGitHubAccount_Handler,
+		},
+		{
+			MethodName: "AddTemporaryBadgeToPlayer",
+			Handler:    _BadgeAdmin_AddTemporaryBadgeToPlayer_Handler,
+		},
+		{
+			MethodName: "GetPlayerBadgeGrants",
+			Handler:    _BadgeAdmin_GetPlayerBadgeGrants_Handler,
+		},
+		{
+			MethodName: "ResetActivePlayerBadge",
+			Handler:    _BadgeAdmin_ResetActivePlayerBadge_Handler,
+		},
+		{
+			MethodName: "GetBadgeAuditRecords",
+			Handler:    _BadgeAdmin_GetBadgeAuditRecords_Handler,
+		},
+		{
+			MethodName: "GetBadgeStats",
+			Handler:    _BadgeAdmin_GetBadgeStats_Handler,
+		},
+		{
+			MethodName: "GetBadgeGroups",
+			Handler:    _BadgeAdmin_GetBadgeGroups_Handler,
+		},
+		{
+			MethodName: "GetBadgeDefinitions",
+			Handler:    _BadgeAdmin_GetBadgeDefinitions_Handler,
+		},
+		{
+			MethodName: "CreateBadge",
+			Handler:    _BadgeAdmin_CreateBadge_Handler,
+		},
+		{
+			MethodName: "UpdateBadge",
+			Handler:    _BadgeAdmin_UpdateBadge_Handler,
+		},
+		{
+			MethodName: "SetBadgeArchived",
+			Handler:    _BadgeAdmin_SetBadgeArchived_Handler,
+		},
+		{
+			MethodName: "ReorderBadges",
+			Handler:    _BadgeAdmin_ReorderBadges_Handler,
+		},
+		{
+			MethodName: "CreateBadgeJob",
+			Handler:    _BadgeAdmin_CreateBadgeJob_Handler,
+		},
+		{
+			MethodName: "GetBadgeJob",
+			Handler:    _BadgeAdmin_GetBadgeJob_Handler,
+		},
+		{
+			MethodName: "GetBadgeJobs",
+			Handler:    _BadgeAdmin_GetBadgeJobs_Handler,
+		},
+		{
+			MethodName: "CancelBadgeJob",
+			Handler:    _BadgeAdmin_CancelBadgeJob_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "badge/grpc.proto",
+}
@@ -0,0 +1,515 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v4.24.4
+// source: experience/grpc.proto
+
+package experience
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ExperienceManagerClient is the client API for ExperienceManager service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ExperienceManagerClient interface {
+	// GrantExperience functions like McPlayer#AddExperienceToPlayers but reports
+	// how much experience each player actually received after multipliers and caps
+	GrantExperience(ctx context.Context, in *GrantExperienceRequest, opts ...grpc.CallOption) (*GrantExperienceResponse, error)
+	// GetPlayerLevel returns a player's lifetime experience, level, prestige and tier
+	GetPlayerLevel(ctx context.Context, in *GetPlayerLevelRequest, opts ...grpc.CallOption) (*GetPlayerLevelResponse, error)
+	// GetLevelTiers returns every configured level tier ordered by min_level
+	GetLevelTiers(ctx context.Context, in *GetLevelTiersRequest, opts ...grpc.CallOption) (*GetLevelTiersResponse, error)
+	// Prestige resets the experience of a player at the level cap and increments their prestige
+	Prestige(ctx context.Context, in *PrestigeRequest, opts ...grpc.CallOption) (*PrestigeResponse, error)
+	// RevokeExperienceTransaction undoes a single grant by its transaction ID
+	RevokeExperienceTransaction(ctx context.Context, in *RevokeExperienceTransactionRequest, opts ...grpc.CallOption) (*RevocationResponse, error)
+	// RevokeExperienceByReason undoes every grant for a reason within a time range, e.g. after an exploit
+	RevokeExperienceByReason(ctx context.Context, in *RevokeExperienceByReasonRequest, opts ...grpc.CallOption) (*RevocationResponse, error)
+	GetPlayerSeasons(ctx context.Context, in *GetPlayerSeasonsRequest, opts ...grpc.CallOption) (*GetPlayerSeasonsResponse, error)
+	GetSeasonLeaderboard(ctx context.Context, in *GetSeasonLeaderboardRequest, opts ...grpc.CallOption) (*GetSeasonLeaderboardResponse, error)
+	GetPlayerGameModes(ctx context.Context, in *GetPlayerGameModesRequest, opts ...grpc.CallOption) (*GetPlayerGameModesResponse, error)
+	AddExperienceBooster(ctx context.Context, in *AddExperienceBoosterRequest, opts ...grpc.CallOption) (*AddExperienceBoosterResponse, error)
+	GetExperienceBoosters(ctx context.Context, in *GetExperienceBoostersRequest, opts ...grpc.CallOption) (*GetExperienceBoostersResponse, error)
+	RemoveExperienceBooster(ctx context.Context, in *RemoveExperienceBoosterRequest, opts ...grpc.CallOption) (*RemoveExperienceBoosterResponse, error)
+}
+
+type experienceManagerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExperienceManagerClient(cc grpc.ClientConnInterface) ExperienceManagerClient {
+	return &experienceManagerClient{cc}
+}
+
+func (c *experienceManagerClient) GrantExperience(ctx context.Context, in *GrantExperienceRequest, opts ...grpc.CallOption) (*GrantExperienceResponse, error) {
+	out := new(GrantExperienceResponse)
+	err := c.cc.Invoke(ctx, "/emortal.grpc.experience.ExperienceManager/GrantExperience", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *experienceManagerClient) GetPlayerLevel(ctx context.Context, in *GetPlayerLevelRequest, opts ...grpc.CallOption) (*GetPlayerLevelResponse, error) {
+	out := new(GetPlayerLevelResponse)
+	err := c.cc.Invoke(ctx, "/emortal.grpc.experience.ExperienceManager/GetPlayerLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *experienceManagerClient) GetLevelTiers(ctx context.Context, in *GetLevelTiersRequest, opts ...grpc.CallOption) (*GetLevelTiersResponse, error) {
+	out := new(GetLevelTiersResponse)
+	err := c.cc.Invoke(ctx, "/emortal.grpc.experience.ExperienceManager/GetLevelTiers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *experienceManagerClient) Prestige(ctx context.Context, in *PrestigeRequest, opts ...grpc.CallOption) (*PrestigeResponse, error) {
+	out := new(PrestigeResponse)
+	err := c.cc.Invoke(ctx, "/emortal.grpc.experience.ExperienceManager/Prestige", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *experienceManagerClient) RevokeExperienceTransaction(ctx context.Context, in *RevokeExperienceTransactionRequest, opts ...grpc.CallOption) (*RevocationResponse, error) {
+	out := new(RevocationResponse)
+	err := c.cc.Invoke(ctx, "/emortal.grpc.experience.ExperienceManager/RevokeExperienceTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *experienceManagerClient) RevokeExperienceByReason(ctx context.Context, in *RevokeExperienceByReasonRequest, opts ...grpc.CallOption) (*RevocationResponse, error) {
+	out := new(RevocationResponse)
+	err := c.cc.Invoke(ctx, "/emortal.grpc.experience.ExperienceManager/RevokeExperienceByReason", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *experienceManagerClient) GetPlayerSeasons(ctx context.Context, in *GetPlayerSeasonsRequest, opts ...grpc.CallOption) (*GetPlayerSeasonsResponse, error) {
+	out := new(GetPlayerSeasonsResponse)
+	err := c.cc.Invoke(ctx, "/emortal.grpc.experience.ExperienceManager/GetPlayerSeasons", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *experienceManagerClient) GetSeasonLeaderboard(ctx context.Context, in *GetSeasonLeaderboardRequest, opts ...grpc.CallOption) (*GetSeasonLeaderboardResponse, error) {
+	out := new(GetSeasonLeaderboardResponse)
+	err := c.cc.Invoke(ctx, "/emortal.grpc.experience.ExperienceManager/GetSeasonLeaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *experienceManagerClient) GetPlayerGameModes(ctx context.Context, in *GetPlayerGameModesRequest, opts ...grpc.CallOption) (*GetPlayerGameModesResponse, error) {
+	out := new(GetPlayerGameModesResponse)
+	err := c.cc.Invoke(ctx, "/emortal.grpc.experience.ExperienceManager/GetPlayerGameModes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *experienceManagerClient) AddExperienceBooster(ctx context.Context, in *AddExperienceBoosterRequest, opts ...grpc.CallOption) (*AddExperienceBoosterResponse, error) {
+	out := new(AddExperienceBoosterResponse)
+	err := c.cc.Invoke(ctx, "/emortal.grpc.experience.ExperienceManager/AddExperienceBooster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *experienceManagerClient) GetExperienceBoosters(ctx context.Context, in *GetExperienceBoostersRequest, opts ...grpc.CallOption) (*GetExperienceBoostersResponse, error) {
+	out := new(GetExperienceBoostersResponse)
+	err := c.cc.Invoke(ctx, "/emortal.grpc.experience.ExperienceManager/GetExperienceBoosters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *experienceManagerClient) RemoveExperienceBooster(ctx context.Context, in *RemoveExperienceBoosterRequest, opts ...grpc.CallOption) (*RemoveExperienceBoosterResponse, error) {
+	out := new(RemoveExperienceBoosterResponse)
+	err := c.cc.Invoke(ctx, "/emortal.grpc.experience.ExperienceManager/RemoveExperienceBooster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExperienceManagerServer is the server API for ExperienceManager service.
+// All implementations must embed UnimplementedExperienceManagerServer
+// for forward compatibility
+type ExperienceManagerServer interface {
+	// GrantExperience functions like McPlayer#AddExperienceToPlayers but reports
+	// how much experience each player actually received after multipliers and caps
+	GrantExperience(context.Context, *GrantExperienceRequest) (*GrantExperienceResponse, error)
+	// GetPlayerLevel returns a player's lifetime experience, level, prestige and tier
+	GetPlayerLevel(context.Context, *GetPlayerLevelRequest) (*GetPlayerLevelResponse, error)
+	// GetLevelTiers returns every configured level tier ordered by min_level
+	GetLevelTiers(context.Context, *GetLevelTiersRequest) (*GetLevelTiersResponse, error)
+	// Prestige resets the experience of a player at the level cap and increments their prestige
+	Prestige(context.Context, *PrestigeRequest) (*PrestigeResponse, error)
+	// RevokeExperienceTransaction undoes a single grant by its transaction ID
+	RevokeExperienceTransaction(context.Context, *RevokeExperienceTransactionRequest) (*RevocationResponse, error)
+	// RevokeExperienceByReason undoes every grant for a reason within a time range, e.g. after an exploit
+	RevokeExperienceByReason(context.Context, *RevokeExperienceByReasonRequest) (*RevocationResponse, error)
+	GetPlayerSeasons(context.Context, *GetPlayerSeasonsRequest) (*GetPlayerSeasonsResponse, error)
+	GetSeasonLeaderboard(context.Context, *GetSeasonLeaderboardRequest) (*GetSeasonLeaderboardResponse, error)
+	GetPlayerGameModes(context.Context, *GetPlayerGameModesRequest) (*GetPlayerGameModesResponse, error)
+	AddExperienceBooster(context.Context, *AddExperienceBoosterRequest) (*AddExperienceBoosterResponse, error)
+	GetExperienceBoosters(context.Context, *GetExperienceBoostersRequest) (*GetExperienceBoostersResponse, error)
+	RemoveExperienceBooster(context.Context, *RemoveExperienceBoosterRequest) (*RemoveExperienceBoosterResponse, error)
+	mustEmbedUnimplementedExperienceManagerServer()
+}
+
+// UnimplementedExperienceManagerServer must be embedded to have forward compatible implementations.
+type UnimplementedExperienceManagerServer struct {
+}
+
+func (UnimplementedExperienceManagerServer) GrantExperience(context.Context, *GrantExperienceRequest) (*GrantExperienceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantExperience not implemented")
+}
+func (UnimplementedExperienceManagerServer) GetPlayerLevel(context.Context, *GetPlayerLevelRequest) (*GetPlayerLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerLevel not implemented")
+}
+func (UnimplementedExperienceManagerServer) GetLevelTiers(context.Context, *GetLevelTiersRequest) (*GetLevelTiersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLevelTiers not implemented")
+}
+func (UnimplementedExperienceManagerServer) Prestige(context.Context, *PrestigeRequest) (*PrestigeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Prestige not implemented")
+}
+func (UnimplementedExperienceManagerServer) RevokeExperienceTransaction(context.Context, *RevokeExperienceTransactionRequest) (*RevocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeExperienceTransaction not implemented")
+}
+func (UnimplementedExperienceManagerServer) RevokeExperienceByReason(context.Context, *RevokeExperienceByReasonRequest) (*RevocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeExperienceByReason not implemented")
+}
+func (UnimplementedExperienceManagerServer) GetPlayerSeasons(context.Context, *GetPlayerSeasonsRequest) (*GetPlayerSeasonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerSeasons not implemented")
+}
+func (UnimplementedExperienceManagerServer) GetSeasonLeaderboard(context.Context, *GetSeasonLeaderboardRequest) (*GetSeasonLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeasonLeaderboard not implemented")
+}
+func (UnimplementedExperienceManagerServer) GetPlayerGameModes(context.Context, *GetPlayerGameModesRequest) (*GetPlayerGameModesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerGameModes not implemented")
+}
+func (UnimplementedExperienceManagerServer) AddExperienceBooster(context.Context, *AddExperienceBoosterRequest) (*AddExperienceBoosterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddExperienceBooster not implemented")
+}
+func (UnimplementedExperienceManagerServer) GetExperienceBoosters(context.Context, *GetExperienceBoostersRequest) (*GetExperienceBoostersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExperienceBoosters not implemented")
+}
+func (UnimplementedExperienceManagerServer) RemoveExperienceBooster(context.Context, *RemoveExperienceBoosterRequest) (*RemoveExperienceBoosterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveExperienceBooster not implemented")
+}
+func (UnimplementedExperienceManagerServer) mustEmbedUnimplementedExperienceManagerServer() {}
+
+// UnsafeExperienceManagerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExperienceManagerServer will
+// result in compilation errors.
+type UnsafeExperienceManagerServer interface {
+	mustEmbedUnimplementedExperienceManagerServer()
+}
+
+func RegisterExperienceManagerServer(s grpc.ServiceRegistrar, srv ExperienceManagerServer) {
+	s.RegisterService(&ExperienceManager_ServiceDesc, srv)
+}
+
+func _ExperienceManager_GrantExperience_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantExperienceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperienceManagerServer).GrantExperience(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.grpc.experience.ExperienceManager/GrantExperience",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperienceManagerServer).GrantExperience(ctx, req.(*GrantExperienceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExperienceManager_GetPlayerLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperienceManagerServer).GetPlayerLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.grpc.experience.ExperienceManager/GetPlayerLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperienceManagerServer).GetPlayerLevel(ctx, req.(*GetPlayerLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExperienceManager_GetLevelTiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLevelTiersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperienceManagerServer).GetLevelTiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.grpc.experience.ExperienceManager/GetLevelTiers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperienceManagerServer).GetLevelTiers(ctx, req.(*GetLevelTiersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExperienceManager_Prestige_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrestigeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperienceManagerServer).Prestige(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.grpc.experience.ExperienceManager/Prestige",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperienceManagerServer).Prestige(ctx, req.(*PrestigeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExperienceManager_RevokeExperienceTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeExperienceTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperienceManagerServer).RevokeExperienceTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.grpc.experience.ExperienceManager/RevokeExperienceTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperienceManagerServer).RevokeExperienceTransaction(ctx, req.(*RevokeExperienceTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExperienceManager_RevokeExperienceByReason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeExperienceByReasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperienceManagerServer).RevokeExperienceByReason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.grpc.experience.ExperienceManager/RevokeExperienceByReason",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperienceManagerServer).RevokeExperienceByReason(ctx, req.(*RevokeExperienceByReasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExperienceManager_GetPlayerSeasons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerSeasonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperienceManagerServer).GetPlayerSeasons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.grpc.experience.ExperienceManager/GetPlayerSeasons",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperienceManagerServer).GetPlayerSeasons(ctx, req.(*GetPlayerSeasonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExperienceManager_GetSeasonLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeasonLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperienceManagerServer).GetSeasonLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.grpc.experience.ExperienceManager/GetSeasonLeaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperienceManagerServer).GetSeasonLeaderboard(ctx, req.(*GetSeasonLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExperienceManager_GetPlayerGameModes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerGameModesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperienceManagerServer).GetPlayerGameModes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.grpc.experience.ExperienceManager/GetPlayerGameModes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperienceManagerServer).GetPlayerGameModes(ctx, req.(*GetPlayerGameModesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExperienceManager_AddExperienceBooster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddExperienceBoosterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperienceManagerServer).AddExperienceBooster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.grpc.experience.ExperienceManager/AddExperienceBooster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperienceManagerServer).AddExperienceBooster(ctx, req.(*AddExperienceBoosterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExperienceManager_GetExperienceBoosters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExperienceBoostersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperienceManagerServer).GetExperienceBoosters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.grpc.experience.ExperienceManager/GetExperienceBoosters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperienceManagerServer).GetExperienceBoosters(ctx, req.(*GetExperienceBoostersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExperienceManager_RemoveExperienceBooster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveExperienceBoosterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperienceManagerServer).RemoveExperienceBooster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.grpc.experience.ExperienceManager/RemoveExperienceBooster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperienceManagerServer).RemoveExperienceBooster(ctx, req.(*RemoveExperienceBoosterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ExperienceManager_ServiceDesc is the grpc.ServiceDesc for ExperienceManager service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExperienceManager_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "emortal.grpc.experience.ExperienceManager",
+	HandlerType: (*ExperienceManagerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GrantExperience",
+			Handler:    _ExperienceManager_GrantExperience_Handler,
+		},
+		{
+			MethodName: "GetPlayerLevel",
+			Handler:    _ExperienceManager_GetPlayerLevel_Handler,
+		},
+		{
+			MethodName: "GetLevelTiers",
+			Handler:    _ExperienceManager_GetLevelTiers_Handler,
+		},
+		{
+			MethodName: "Prestige",
+			Handler:    _ExperienceManager_Prestige_Handler,
+		},
+		{
+			MethodName: "RevokeExperienceTransaction",
+			Handler:    _ExperienceManager_RevokeExperienceTransaction_Handler,
+		},
+		{
+			MethodName: "RevokeExperienceByReason",
+			Handler:    _ExperienceManager_RevokeExperienceByReason_Handler,
+		},
+		{
+			MethodName: "GetPlayerSeasons",
+			Handler:    _ExperienceManager_GetPlayerSeasons_Handler,
+		},
+		{
+			MethodName: "GetSeasonLeaderboard",
+			Handler:    _ExperienceManager_GetSeasonLeaderboard_Handler,
+		},
+		{
+			MethodName: "GetPlayerGameModes",
+			Handler:    _ExperienceManager_GetPlayerGameModes_Handler,
+		},
+		{
+			MethodName: "AddExperienceBooster",
+			Handler:    _ExperienceManager_AddExperienceBooster_Handler,
+		},
+		{
+			MethodName: "GetExperienceBoosters",
+			Handler:    _ExperienceManager_GetExperienceBoosters_Handler,
+		},
+		{
+			MethodName: "RemoveExperienceBooster",
+			Handler:    _ExperienceManager_RemoveExperienceBooster_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "experience/grpc.proto",
+}
@@ -0,0 +1,149 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.24.4
+// source: badge/messages.proto
+
+package badgeadmin
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// BadgeCatalogueChangedMessage is sent when badges stored in the database are changed,
+// so that every replica of the mc-player-service reloads them
+type BadgeCatalogueChangedMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BadgeIds []string `protobuf:"bytes,1,rep,name=badge_ids,json=badgeIds,proto3" json:"badge_ids,omitempty"`
+}
+
+func (x *BadgeCatalogueChangedMessage) Reset() {
+	*x = BadgeCatalogueChangedMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_badge_messages_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BadgeCatalogueChangedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BadgeCatalogueChangedMessage) ProtoMessage() {}
+
+func (x *BadgeCatalogueChangedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_badge_messages_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BadgeCatalogueChangedMessage.ProtoReflect.Descriptor instead.
+func (*BadgeCatalogueChangedMessage) Descriptor() ([]byte, []int) {
+	return file_badge_messages_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BadgeCatalogueChangedMessage) GetBadgeIds() []string {
+	if x != nil {
+		return x.BadgeIds
+	}
+	return nil
+}
+
+var File_badge_messages_proto protoreflect.FileDescriptor
+
+var file_badge_messages_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x62, 0x61, 0x64, 0x67, 0x65, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1a, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x22, 0x3b, 0x0a, 0x1c, 0x42, 0x61, 0x64, 0x67, 0x65, 0x43, 0x61, 0x74, 0x61, 0x6c,
+	0x6f, 0x67, 0x75, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x62, 0x61, 0x64, 0x67, 0x65, 0x49, 0x64, 0x73, 0x42,
+	0x2d, 0x5a, 0x2b, 0x6d, 0x63, 0x2d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2d, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2f, 0x62, 0x61, 0x64, 0x67, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_badge_messages_proto_rawDescOnce sync.Once
+	file_badge_messages_proto_rawDescData = file_badge_messages_proto_rawDesc
+)
+
+func file_badge_messages_proto_rawDescGZIP() []byte {
+	file_badge_messages_proto_rawDescOnce.Do(func() {
+		file_badge_messages_proto_rawDescData = protoimpl.X.CompressGZIP(file_badge_messages_proto_rawDescData)
+	})
+	return file_badge_messages_proto_rawDescData
+}
+
+var file_badge_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_badge_messages_proto_goTypes = []interface{}{
+	(*BadgeCatalogueChangedMessage)(nil), // 0: emortal.message.badgeadmin.BadgeCatalogueChangedMessage
+}
+var file_badge_messages_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_badge_messages_proto_init() }
+func file_badge_messages_proto_init() {
+	if File_badge_messages_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_badge_messages_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BadgeCatalogueChangedMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_badge_messages_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_badge_messages_proto_goTypes,
+		DependencyIndexes: file_badge_messages_proto_depIdxs,
+		MessageInfos:      file_badge_messages_proto_msgTypes,
+	}.Build()
+	File_badge_messages_proto = out.File
+	file_badge_messages_proto_rawDesc = nil
+	file_badge_messages_proto_goTypes = nil
+	file_badge_messages_proto_depIdxs = nil
+}
@@ -0,0 +1,268 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.24.4
+// source: experience/messages.proto
+
+package experience
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ExperienceGrantMessage is consumed by the mc-player-service to give experience to players asynchronously,
+// so a game server does not lose experience if a synchronous gRPC call fails
+type ExperienceGrantMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerIds  []string `protobuf:"bytes,1,rep,name=player_ids,json=playerIds,proto3" json:"player_ids,omitempty"`
+	Experience uint64   `protobuf:"varint,2,opt,name=experience,proto3" json:"experience,omitempty"`
+	Reason     string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// idempotency_key must be unique per grant. If a message is redelivered,
+	// players that already received experience with this key are skipped.
+	IdempotencyKey string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+}
+
+func (x *ExperienceGrantMessage) Reset() {
+	*x = ExperienceGrantMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_experience_messages_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExperienceGrantMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperienceGrantMessage) ProtoMessage() {}
+
+func (x *ExperienceGrantMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_experience_messages_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperienceGrantMessage.ProtoReflect.Descriptor instead.
+func (*ExperienceGrantMessage) Descriptor() ([]byte, []int) {
+	return file_experience_messages_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExperienceGrantMessage) GetPlayerIds() []string {
+	if x != nil {
+		return x.PlayerIds
+	}
+	return nil
+}
+
+func (x *ExperienceGrantMessage) GetExperience() uint64 {
+	if x != nil {
+		return x.Experience
+	}
+	return 0
+}
+
+func (x *ExperienceGrantMessage) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ExperienceGrantMessage) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+// PlayerPrestigeMessage is sent when a player at the level cap prestiges, resetting their experience
+type PlayerPrestigeMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId    string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	NewPrestige uint32 `protobuf:"varint,2,opt,name=new_prestige,json=newPrestige,proto3" json:"new_prestige,omitempty"`
+	// previous_experience is the experience the player had before it was reset to 0
+	PreviousExperience int64 `protobuf:"varint,3,opt,name=previous_experience,json=previousExperience,proto3" json:"previous_experience,omitempty"`
+}
+
+func (x *PlayerPrestigeMessage) Reset() {
+	*x = PlayerPrestigeMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_experience_messages_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerPrestigeMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerPrestigeMessage) ProtoMessage() {}
+
+func (x *PlayerPrestigeMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_experience_messages_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerPrestigeMessage.ProtoReflect.Descriptor instead.
+func (*PlayerPrestigeMessage) Descriptor() ([]byte, []int) {
+	return file_experience_messages_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PlayerPrestigeMessage) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *PlayerPrestigeMessage) GetNewPrestige() uint32 {
+	if x != nil {
+		return x.NewPrestige
+	}
+	return 0
+}
+
+func (x *PlayerPrestigeMessage) GetPreviousExperience() int64 {
+	if x != nil {
+		return x.PreviousExperience
+	}
+	return 0
+}
+
+var File_experience_messages_proto protoreflect.FileDescriptor
+
+var file_experience_messages_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1a, 0x65, 0x6d, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x65, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65,
+	0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b,
+	0x65, 0x79, 0x22, 0x88, 0x01, 0x0a, 0x15, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x65,
+	0x73, 0x74, 0x69, 0x67, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77,
+	0x5f, 0x70, 0x72, 0x65, 0x73, 0x74, 0x69, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x6e, 0x65, 0x77, 0x50, 0x72, 0x65, 0x73, 0x74, 0x69, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x13,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x2d, 0x5a,
+	0x2b, 0x6d, 0x63, 0x2d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_experience_messages_proto_rawDescOnce sync.Once
+	file_experience_messages_proto_rawDescData = file_experience_messages_proto_rawDesc
+)
+
+func file_experience_messages_proto_rawDescGZIP() []byte {
+	file_experience_messages_proto_rawDescOnce.Do(func() {
+		file_experience_messages_proto_rawDescData = protoimpl.X.CompressGZIP(file_experience_messages_proto_rawDescData)
+	})
+	return file_experience_messages_proto_rawDescData
+}
+
+var file_experience_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_experience_messages_proto_goTypes = []interface{}{
+	(*ExperienceGrantMessage)(nil), // 0: emortal.message.experience.ExperienceGrantMessage
+	(*PlayerPrestigeMessage)(nil),  // 1: emortal.message.experience.PlayerPrestigeMessage
+}
+var file_experience_messages_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_experience_messages_proto_init() }
+func file_experience_messages_proto_init() {
+	if File_experience_messages_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_experience_messages_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExperienceGrantMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_experience_messages_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerPrestigeMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_experience_messages_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_experience_messages_proto_goTypes,
+		DependencyIndexes: file_experience_messages_proto_depIdxs,
+		MessageInfos:      file_experience_messages_proto_msgTypes,
+	}.Build()
+	File_experience_messages_proto = out.File
+	file_experience_messages_proto_rawDesc = nil
+	file_experience_messages_proto_goTypes = nil
+	file_experience_messages_proto_depIdxs = nil
+}
@@ -4,8 +4,10 @@ go 1.21
 
 require (
 	github.com/emortalmc/proto-specs/gen/go v0.0.0-20240406012921-6a9ad1aff227
+	github.com/fsnotify/fsnotify v1.6.0
 	github.com/google/uuid v1.5.0
 	github.com/grpc-ecosystem/go-grpc-middleware v1.4.0
+	github.com/mitchellh/mapstructure v1.5.0
 	github.com/segmentio/kafka-go v0.4.46
 	github.com/spf13/viper v1.17.0
 	go.mongodb.org/mongo-driver v1.13.1
@@ -15,13 +17,11 @@ require (
 )
 
 require (
-	github.com/fsnotify/fsnotify v1.6.0 // indirect
 	github.com/golang/protobuf v1.5.3 // indirect
 	github.com/golang/snappy v0.0.1 // indirect
 	github.com/hashicorp/hcl v1.0.0 // indirect
 	github.com/klauspost/compress v1.17.0 // indirect
 	github.com/magiconair/properties v1.8.7 // indirect
-	github.com/mitchellh/mapstructure v1.5.0 // indirect
 	github.com/montanaflynn/stats v0.0.0-20171201202039-1bf9dbcd8cbe // indirect
 	github.com/pelletier/go-toml/v2 v2.1.0 // indirect
 	github.com/pierrec/lz4/v4 v4.1.15 // indirect
@@ -0,0 +1,74 @@
+package badge
+
+import (
+	"context"
+	"github.com/emortalmc/proto-specs/gen/go/model/common"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"mc-player-service/internal/repository/model"
+	"time"
+)
+
+const (
+	AuditActionGrant       = "grant"
+	AuditActionRevoke      = "revoke"
+	AuditActionSetActive   = "set_active"
+	AuditActionResetActive = "reset_active"
+	AuditActionExpire      = "expire"
+
+	// ActorAutomatic changes made by the service itself, e.g. rewards and expiry
+	ActorAutomatic = "automatic"
+	// ActorRoleSync changes made to keep role badges in line with permission roles
+	ActorRoleSync = "role-sync"
+	ActorUnknown  = "unknown"
+)
+
+// Audit describes who is changing a player's badges and why
+type Audit struct {
+	Actor  string
+	Source string
+	Reason string
+}
+
+type auditKey struct{}
+
+// WithAudit attaches the audit to the context so that badge changes made with it are recorded against it
+func WithAudit(ctx context.Context, audit Audit) context.Context {
+	return context.WithValue(ctx, auditKey{}, audit)
+}
+
+// AuditFromContext returns the audit attached with WithAudit, with the actor defaulting to ActorUnknown
+func AuditFromContext(ctx context.Context) Audit {
+	audit, ok := ctx.Value(auditKey{}).(Audit)
+	if !ok || audit.Actor == "" {
+		audit.Actor = ActorUnknown
+	}
+
+	return audit
+}
+
+// recordAudit logs rather than returns errors so that a failure to record doesn't undo the change
+func (s *serviceImpl) recordAudit(ctx context.Context, playerID uuid.UUID, badgeID string, action string) {
+	audit := AuditFromContext(ctx)
+
+	record := model.BadgeAuditRecord{
+		ID:        primitive.NewObjectID(),
+		PlayerID:  playerID,
+		BadgeID:   badgeID,
+		Action:    action,
+		Actor:     audit.Actor,
+		Source:    audit.Source,
+		Reason:    audit.Reason,
+		Timestamp: time.Now(),
+	}
+
+	if err := s.repo.CreateBadgeAuditRecord(ctx, record); err != nil {
+		s.log.Errorw("failed to create badge audit record", "playerId", playerID, "badgeId", badgeID, "action", action, "error", err)
+	}
+}
+
+func (s *serviceImpl) GetAuditRecords(ctx context.Context, playerID *uuid.UUID, badgeID *string,
+	pageable *common.Pageable) ([]model.BadgeAuditRecord, *common.PageData, error) {
+
+	return s.repo.GetBadgeAuditRecords(ctx, playerID, badgeID, pageable)
+}
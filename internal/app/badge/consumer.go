@@ -5,31 +5,67 @@ import (
 	permmsg "github.com/emortalmc/proto-specs/gen/go/message/permission"
 	"github.com/google/uuid"
 	"mc-player-service/internal/config"
+	"slices"
 )
 
 func (s *serviceImpl) HandlePlayerRolesUpdate(ctx context.Context, playerID uuid.UUID, roleID string,
 	changeType permmsg.PlayerRolesUpdateMessage_ChangeType) {
 
-	var badge *config.Badge
-	for id, b := range s.badgeCfg.Badges {
-		if b.AutomaticGrants == nil || b.AutomaticGrants.PermissionRole == nil {
-			continue
+	badges := badgesForRole(s.badgeCfg.Get(), roleID)
+	if len(badges) == 0 {
+		return
+	}
+
+	ctx = WithAudit(ctx, Audit{Actor: ActorRoleSync, Source: "permission-update", Reason: "role " + roleID + " " + changeType.String()})
+	playerRoles, known := s.getRolesAfterUpdate(ctx, playerID, roleID, changeType)
+
+	for _, badge := range badges {
+		added := changeType == permmsg.PlayerRolesUpdateMessage_ADD
+
+		var qualifies bool
+		if known {
+			qualifies = badge.AutomaticGrants.QualifiesForRoles(playerRoles)
+		} else {
+			// Only the updated role is known, so the outcome is only certain when adding an "any" role,
+			// removing an "all" role, or when the badge has a single role
+			matchAll := badge.AutomaticGrants.PermissionRoleMatch == config.RoleMatchAll
+			if len(badge.AutomaticGrants.Roles()) > 1 && added == matchAll {
+				s.log.Debugw("skipping role badge as the player's other roles are unknown", "playerId", playerID, "badgeId", badge.Id)
+				continue
+			}
+			qualifies = added
 		}
 
-		if *b.AutomaticGrants.PermissionRole == roleID {
-			badge = s.badgeCfg.Badges[id]
-			break
+		if err := s.applyRoleBadge(ctx, playerID, badge.Id, qualifies); err != nil {
+			s.log.Errorw("error updating player badge", "playerId", playerID, "badgeId", badge.Id, "error", err)
 		}
 	}
+}
+
+// getRolesAfterUpdate returns all the player's roles with the update applied,
+// or false if they are unknown because there is no role source or it failed
+func (s *serviceImpl) getRolesAfterUpdate(ctx context.Context, playerID uuid.UUID, roleID string,
+	changeType permmsg.PlayerRolesUpdateMessage_ChangeType) ([]string, bool) {
+
+	if s.roles == nil {
+		return nil, false
+	}
 
-	var err error
+	roles, err := s.roles.GetPlayerRoles(ctx, playerID)
+	if err != nil {
+		s.log.Warnw("failed to get player roles, using only the updated role", "playerId", playerID, "error", err)
+		return nil, false
+	}
+
+	// The role source may not reflect the update yet
 	switch changeType {
 	case permmsg.PlayerRolesUpdateMessage_ADD:
-		err = s.AddBadgeToPlayer(ctx, playerID, badge.Id)
+		if !slices.Contains(roles, roleID) {
+			roles = append(roles, roleID)
+		}
 	case permmsg.PlayerRolesUpdateMessage_REMOVE:
-		err = s.RemoveBadgeFromPlayer(ctx, playerID, badge.Id)
-	}
-	if err != nil {
-		s.log.Errorw("error updating player badge", "error", err)
+		roles = slices.DeleteFunc(roles, func(role string) bool { return role == roleID })
 	}
+
+	return roles, true
 }
@@ -0,0 +1,136 @@
+package badge
+
+import (
+	"context"
+	permmsg "github.com/emortalmc/proto-specs/gen/go/message/permission"
+	"github.com/google/uuid"
+	"mc-player-service/internal/config"
+	"mc-player-service/internal/repository/model"
+	"slices"
+	"testing"
+)
+
+func TestHandlePlayerRolesUpdate(t *testing.T) {
+	playerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	const (
+		add    = permmsg.PlayerRolesUpdateMessage_ADD
+		remove = permmsg.PlayerRolesUpdateMessage_REMOVE
+	)
+
+	tests := []struct {
+		name   string
+		badges []*config.Badge
+		// roles the player's roles according to the role source, or nil if there is no role source
+		roles []string
+		owned []string
+
+		role       string
+		changeType permmsg.PlayerRolesUpdateMessage_ChangeType
+
+		wantBadges []string
+	}{
+		{
+			name:       "unmapped role is ignored",
+			badges:     []*config.Badge{roleBadge("staff", false, "moderator"), {Id: "plain"}},
+			roles:      []string{"builder"},
+			owned:      []string{"staff"},
+			role:       "builder",
+			changeType: remove,
+			wantBadges: []string{"staff"},
+		},
+		{
+			name:       "unmapped role is ignored without a role source",
+			badges:     []*config.Badge{roleBadge("staff", false, "moderator")},
+			role:       "builder",
+			changeType: add,
+			wantBadges: nil,
+		},
+		{
+			name:       "badge added for role",
+			badges:     []*config.Badge{roleBadge("staff", false, "moderator", "admin")},
+			roles:      []string{},
+			role:       "moderator",
+			changeType: add,
+			wantBadges: []string{"staff"},
+		},
+		{
+			name:       "every badge mapped to the role is added",
+			badges:     []*config.Badge{roleBadge("staff", false, "moderator"), roleBadge("mod", false, "moderator")},
+			roles:      []string{"moderator"},
+			role:       "moderator",
+			changeType: add,
+			wantBadges: []string{"mod", "staff"},
+		},
+		{
+			name:       "badge kept while another of its roles remains",
+			badges:     []*config.Badge{roleBadge("staff", false, "moderator", "admin")},
+			roles:      []string{"moderator", "admin"},
+			owned:      []string{"staff"},
+			role:       "moderator",
+			changeType: remove,
+			wantBadges: []string{"staff"},
+		},
+		{
+			name:       "badge removed with its last role",
+			badges:     []*config.Badge{roleBadge("staff", false, "moderator", "admin")},
+			roles:      []string{"moderator"},
+			owned:      []string{"staff"},
+			role:       "moderator",
+			changeType: remove,
+			wantBadges: []string{},
+		},
+		{
+			name:       "match all badge needs every role",
+			badges:     []*config.Badge{roleBadge("lead", true, "moderator", "admin")},
+			roles:      []string{},
+			role:       "moderator",
+			changeType: add,
+			wantBadges: nil,
+		},
+		{
+			name:       "match all badge added with its last role",
+			badges:     []*config.Badge{roleBadge("lead", true, "moderator", "admin")},
+			roles:      []string{"admin"},
+			role:       "moderator",
+			changeType: add,
+			wantBadges: []string{"lead"},
+		},
+		{
+			name:       "badge kept without a role source as other roles may remain",
+			badges:     []*config.Badge{roleBadge("staff", false, "moderator", "admin")},
+			owned:      []string{"staff"},
+			role:       "moderator",
+			changeType: remove,
+			wantBadges: []string{"staff"},
+		},
+		{
+			name:       "single role badge removed without a role source",
+			badges:     []*config.Badge{roleBadge("staff", false, "moderator")},
+			owned:      []string{"staff"},
+			role:       "moderator",
+			changeType: remove,
+			wantBadges: []string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repo := newFakeRepo(model.Player{ID: playerID, Badges: slices.Clone(test.owned)})
+
+			var roles RoleSource
+			if test.roles != nil {
+				roles = StaticRoleSource{playerID: test.roles}
+			}
+			svc := newTestService(t, repo, roles, test.badges...)
+
+			svc.HandlePlayerRolesUpdate(context.Background(), playerID, test.role, test.changeType)
+
+			got := slices.Clone(repo.badges(playerID))
+			slices.Sort(got)
+			if !slices.Equal(got, test.wantBadges) {
+				t.Errorf("badges = %v, want %v", got, test.wantBadges)
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package badge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"mc-player-service/internal/config"
+	"mc-player-service/internal/repository/model"
+	"mc-player-service/internal/utils/experience"
+	"slices"
+	"sort"
+	"time"
+)
+
+const criteriaSweepBatchSize = 500
+
+type CriteriaSweepReport struct {
+	PlayersChecked int
+	// PlayersFailed players whose badges couldn't be updated. They may have been partially updated.
+	PlayersFailed int
+	Granted       int
+	Revoked       int
+}
+
+func (s *serviceImpl) EvaluateBadgeCriteria(ctx context.Context, playerID uuid.UUID) error {
+	badges := criteriaBadges(s.badgeCfg.Get())
+	if len(badges) == 0 {
+		return nil
+	}
+
+	player, err := s.repo.GetCriteriaPlayer(ctx, playerID)
+	if err != nil {
+		return fmt.Errorf("failed to get player: %w", err)
+	}
+
+	_, _, err = s.applyBadgeCriteria(ctx, badges, player, time.Now())
+	return err
+}
+
+func (s *serviceImpl) SweepBadgeCriteria(ctx context.Context) (CriteriaSweepReport, error) {
+	var report CriteriaSweepReport
+
+	badges := criteriaBadges(s.badgeCfg.Get())
+	if len(badges) == 0 {
+		return report, nil
+	}
+
+	after := uuid.Nil
+	for {
+		players, err := s.repo.GetCriteriaPlayersAfter(ctx, after, criteriaSweepBatchSize)
+		if err != nil {
+			return report, fmt.Errorf("failed to get players: %w", err)
+		}
+
+		now := time.Now()
+		for _, player := range players {
+			after = player.ID
+
+			granted, revoked, err := s.applyBadgeCriteria(ctx, badges, player, now)
+			report.Granted += granted
+			report.Revoked += revoked
+			if err != nil {
+				if ctx.Err() != nil {
+					return report, ctx.Err()
+				}
+
+				s.log.Errorw("failed to apply badge criteria", "playerId", player.ID, "error", err)
+				report.PlayersFailed++
+				continue
+			}
+			report.PlayersChecked++
+		}
+
+		if len(players) < criteriaSweepBatchSize {
+			return report, nil
+		}
+	}
+}
+
+// evaluateDependentCriteria evaluates the player's badge criteria if any depend on owning the badge
+func (s *serviceImpl) evaluateDependentCriteria(ctx context.Context, playerID uuid.UUID, badgeID string) {
+	for _, badge := range criteriaBadges(s.badgeCfg.Get()) {
+		if slices.Contains(badge.AutomaticGrants.Criteria.OwnsBadges, badgeID) {
+			if err := s.EvaluateBadgeCriteria(ctx, playerID); err != nil {
+				s.log.Errorw("failed to evaluate badge criteria", "playerId", playerID, "badgeId", badgeID, "error", err)
+			}
+			return
+		}
+	}
+}
+
+// applyBadgeCriteria grants and revokes the player's criteria badges, returning how many were granted and revoked
+func (s *serviceImpl) applyBadgeCriteria(ctx context.Context, badges []*config.Badge, player model.CriteriaPlayer,
+	now time.Time) (granted int, revoked int, err error) {
+
+	ctx = WithAudit(ctx, Audit{Actor: ActorAutomatic, Source: "criteria"})
+	owned := targetCriteriaBadges(badges, player, now)
+
+	var errs []error
+	for _, badgeID := range owned {
+		if slices.Contains(player.BadgeIDs, badgeID) {
+			continue
+		}
+
+		if err := s.addBadge(ctx, player.ID, badgeID, nil); err != nil {
+			if !errors.Is(err, AlreadyHasBadgeErr) {
+				errs = append(errs, fmt.Errorf("failed to add badge %s: %w", badgeID, err))
+			}
+			continue
+		}
+		granted++
+	}
+
+	for _, badgeID := range player.BadgeIDs {
+		if slices.Contains(owned, badgeID) {
+			continue
+		}
+
+		if err := s.removeBadge(ctx, player.ID, badgeID); err != nil {
+			if !errors.Is(err, DoesntHaveBadgeErr) {
+				errs = append(errs, fmt.Errorf("failed to remove badge %s: %w", badgeID, err))
+			}
+			continue
+		}
+		revoked++
+	}
+
+	return granted, revoked, errors.Join(errs...)
+}
+
+// targetCriteriaBadges returns the badges the player should own once criteria badges have been granted and revoked.
+// As criteria may depend on owning other criteria badges, they are re-evaluated until nothing changes.
+func targetCriteriaBadges(badges []*config.Badge, player model.CriteriaPlayer, now time.Time) []string {
+	owned := slices.Clone(player.BadgeIDs)
+
+	// Every pass but the last changes at least one badge, so this is only a safeguard against criteria that never settle
+	for i := 0; i <= len(badges); i++ {
+		changed := false
+		for _, badge := range badges {
+			criteria := badge.AutomaticGrants.Criteria
+			has := slices.Contains(owned, badge.Id)
+			qualifies := meetsCriteria(criteria, player, owned, now)
+
+			if qualifies && !has {
+				owned = append(owned, badge.Id)
+				changed = true
+			} else if !qualifies && has && criteria.Revoke {
+				owned = slices.DeleteFunc(owned, func(id string) bool { return id == badge.Id })
+				changed = true
+			}
+		}
+
+		if !changed {
+			break
+		}
+	}
+
+	return owned
+}
+
+func meetsCriteria(c *config.BadgeCriteria, player model.CriteriaPlayer, owned []string, now time.Time) bool {
+	if c.IsEmpty() {
+		return false
+	}
+
+	if c.MinLevel != nil && experience.XPToLevel(int(player.Experience)) < *c.MinLevel {
+		return false
+	}
+	if c.MinPlaytime != nil && player.TotalPlaytime < *c.MinPlaytime {
+		return false
+	}
+	if c.FirstLoginBefore != nil && (player.FirstLogin.IsZero() || !player.FirstLogin.Before(*c.FirstLoginBefore)) {
+		return false
+	}
+	if c.MinLoginStreak != nil && player.CurrentLoginStreak(now) < *c.MinLoginStreak {
+		return false
+	}
+	for _, badgeID := range c.OwnsBadges {
+		if !slices.Contains(owned, badgeID) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// criteriaBadges returns every badge that is automatically granted for criteria, ordered by ID
+func criteriaBadges(cfg *config.BadgeConfig) []*config.Badge {
+	var badges []*config.Badge
+	for _, b := range cfg.Badges {
+		if b.AutomaticGrants != nil && b.AutomaticGrants.Criteria != nil {
+			badges = append(badges, b)
+		}
+	}
+
+	sort.Slice(badges, func(i, j int) bool {
+		return badges[i].Id < badges[j].Id
+	})
+
+	return badges
+}
@@ -0,0 +1,83 @@
+package badge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"mc-player-service/internal/repository/model"
+	"time"
+)
+
+const expiredBadgeBatchSize = 500
+
+var ErrExpiryInPast = errors.New("badge expiry must be in the future")
+
+func (s *serviceImpl) AddTemporaryBadgeToPlayer(ctx context.Context, playerID uuid.UUID, badgeID string, expiresAt time.Time) error {
+	if !expiresAt.After(time.Now()) {
+		return ErrExpiryInPast
+	}
+
+	if err := s.addBadge(ctx, playerID, badgeID, &expiresAt); err != nil {
+		return err
+	}
+
+	s.evaluateDependentCriteria(ctx, playerID, badgeID)
+	return nil
+}
+
+func (s *serviceImpl) ExpireBadges(ctx context.Context) (int, error) {
+	ctx = WithAudit(ctx, Audit{Actor: ActorAutomatic, Source: "expiry"})
+	now := time.Now()
+
+	removed := 0
+	for {
+		grants, err := s.repo.GetExpiredBadgeGrants(ctx, now, expiredBadgeBatchSize)
+		if err != nil {
+			return removed, fmt.Errorf("failed to get expired badge grants: %w", err)
+		}
+
+		for _, grant := range grants {
+			ok, err := s.repo.RemoveExpiredPlayerBadge(ctx, grant.PlayerID, grant.BadgeID, now)
+			if err != nil {
+				return removed, fmt.Errorf("failed to remove expired badge: %w", err)
+			}
+			if !ok {
+				continue
+			}
+			removed++
+			s.recordAudit(ctx, grant.PlayerID, grant.BadgeID, AuditActionExpire)
+			s.notif.PlayerBadgeRemoved(ctx, grant.PlayerID, s.badgeProto(grant.BadgeID))
+
+			if grant.ActiveBadge != nil && *grant.ActiveBadge == grant.BadgeID {
+				if err := s.UpdateActiveBadge(ctx, grant.PlayerID); err != nil {
+					s.log.Errorw("failed to update active badge after expiry", "playerId", grant.PlayerID, "error", err)
+				}
+			}
+			s.evaluateDependentCriteria(ctx, grant.PlayerID, grant.BadgeID)
+		}
+
+		if len(grants) < expiredBadgeBatchSize {
+			return removed, nil
+		}
+	}
+}
+
+func (s *serviceImpl) GetPlayerBadgeGrants(ctx context.Context, playerID uuid.UUID) ([]model.BadgeGrant, error) {
+	player, err := s.repo.GetBadgePlayer(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	grants := make([]model.BadgeGrant, len(player.BadgeIDs))
+	for i, badgeID := range player.BadgeIDs {
+		grant, ok := player.GetGrant(badgeID)
+		if !ok {
+			grant = model.BadgeGrant{BadgeID: badgeID}
+		}
+
+		grants[i] = grant
+	}
+
+	return grants, nil
+}
@@ -0,0 +1,21 @@
+package badge
+
+import (
+	"mc-player-service/internal/config"
+	"sort"
+)
+
+func (s *serviceImpl) GetBadgeGroups() []*config.BadgeGroup {
+	badgeCfg := s.badgeCfg.Get()
+
+	groups := make([]*config.BadgeGroup, 0, len(badgeCfg.Groups))
+	for _, group := range badgeCfg.Groups {
+		groups = append(groups, group)
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Id < groups[j].Id
+	})
+
+	return groups
+}
@@ -0,0 +1,18 @@
+package badge
+
+import (
+	"context"
+	pbmodel "github.com/emortalmc/proto-specs/gen/go/model/badge"
+	"github.com/google/uuid"
+	kafkaWriter "mc-player-service/internal/kafka/writer"
+)
+
+var (
+	_ KafkaWriter = &kafkaWriter.Notifier{}
+)
+
+type KafkaWriter interface {
+	PlayerBadgeAdded(ctx context.Context, playerID uuid.UUID, badge *pbmodel.Badge)
+	PlayerBadgeRemoved(ctx context.Context, playerID uuid.UUID, badge *pbmodel.Badge)
+	PlayerActiveBadgeChanged(ctx context.Context, playerID uuid.UUID, badge *pbmodel.Badge)
+}
@@ -0,0 +1,53 @@
+package badge
+
+import (
+	"context"
+	"fmt"
+	"mc-player-service/internal/config"
+)
+
+func (s *serviceImpl) ApplyConfig(ctx context.Context, cfg config.BadgeConfig) (int, error) {
+	oldCfg := s.badgeCfg.Swap(cfg)
+
+	changed := changedBadgeIDs(oldCfg, &cfg)
+	if len(changed) == 0 {
+		return 0, nil
+	}
+
+	playerIDs, err := s.repo.GetPlayerIDsWithBadges(ctx, changed)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get players with changed badges: %w", err)
+	}
+
+	updated := 0
+	for _, playerID := range playerIDs {
+		if err := s.UpdateActiveBadge(ctx, playerID); err != nil {
+			s.log.Errorw("failed to update active badge after config reload", "playerId", playerID, "error", err)
+			continue
+		}
+		updated++
+	}
+
+	return updated, nil
+}
+
+// changedBadgeIDs returns the IDs of badges that were removed or whose priority, required or forced flag changed,
+// as these affect which badge is active
+func changedBadgeIDs(oldCfg *config.BadgeConfig, newCfg *config.BadgeConfig) []string {
+	var changed []string
+	for id, oldBadge := range oldCfg.Badges {
+		newBadge, ok := newCfg.Badges[id]
+		if !ok || newBadge.Priority != oldBadge.Priority || newBadge.Required != oldBadge.Required ||
+			newBadge.Forced != oldBadge.Forced {
+			changed = append(changed, id)
+		}
+	}
+
+	for id, newBadge := range newCfg.Badges {
+		if _, ok := oldCfg.Badges[id]; !ok && (newBadge.Required || newBadge.Forced) {
+			changed = append(changed, id)
+		}
+	}
+
+	return changed
+}
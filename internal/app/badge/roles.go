@@ -0,0 +1,206 @@
+package badge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	permpb "github.com/emortalmc/proto-specs/gen/go/grpc/permission"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"mc-player-service/internal/config"
+	"slices"
+)
+
+const roleResyncBatchSize = 500
+
+var (
+	ErrNoRoleSource = errors.New("no role source is configured")
+	// ErrPlayerRolesUnknown the role source doesn't know the player, which doesn't mean they have no roles
+	ErrPlayerRolesUnknown = errors.New("player roles are unknown")
+)
+
+// RoleSource provides the permission roles of players
+type RoleSource interface {
+	// GetPlayerRoles returns ErrPlayerRolesUnknown if the role source doesn't know the player
+	GetPlayerRoles(ctx context.Context, playerID uuid.UUID) ([]string, error)
+	// GetAllRoles returns the IDs of every role that exists
+	GetAllRoles(ctx context.Context) ([]string, error)
+}
+
+type permissionRoleSource struct {
+	client permpb.PermissionServiceClient
+}
+
+// NewPermissionRoleSource creates a RoleSource backed by the permission service
+func NewPermissionRoleSource(client permpb.PermissionServiceClient) RoleSource {
+	return &permissionRoleSource{client: client}
+}
+
+func (r *permissionRoleSource) GetPlayerRoles(ctx context.Context, playerID uuid.UUID) ([]string, error) {
+	resp, err := r.client.GetPlayerRoles(ctx, &permpb.GetPlayerRolesRequest{PlayerId: playerID.String()})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, ErrPlayerRolesUnknown
+		}
+		return nil, err
+	}
+
+	return resp.RoleIds, nil
+}
+
+func (r *permissionRoleSource) GetAllRoles(ctx context.Context) ([]string, error) {
+	resp, err := r.client.GetAllRoles(ctx, &permpb.GetAllRolesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(resp.Roles))
+	for i, role := range resp.Roles {
+		ids[i] = role.Id
+	}
+
+	return ids, nil
+}
+
+// StaticRoleSource is a RoleSource with fixed roles, e.g. for tests.
+// Players not in the map are unknown to it, so GetPlayerRoles returns ErrPlayerRolesUnknown for them.
+type StaticRoleSource map[uuid.UUID][]string
+
+func (r StaticRoleSource) GetPlayerRoles(_ context.Context, playerID uuid.UUID) ([]string, error) {
+	roles, ok := r[playerID]
+	if !ok {
+		return nil, ErrPlayerRolesUnknown
+	}
+
+	return slices.Clone(roles), nil
+}
+
+func (r StaticRoleSource) GetAllRoles(_ context.Context) ([]string, error) {
+	var ids []string
+	for _, roles := range r {
+		for _, role := range roles {
+			if !slices.Contains(ids, role) {
+				ids = append(ids, role)
+			}
+		}
+	}
+
+	return ids, nil
+}
+
+type RoleBadgeChange struct {
+	PlayerID uuid.UUID
+	BadgeID  string
+	// Added true if the badge was (or would be) added, false if removed
+	Added bool
+}
+
+type RoleResyncReport struct {
+	PlayersChecked int
+	// PlayersSkipped players the role source doesn't know, so were left unchanged
+	PlayersSkipped int
+	// PlayersFailed players whose roles couldn't be fetched, so were left unchanged
+	PlayersFailed int
+	Changes       []RoleBadgeChange
+}
+
+func (s *serviceImpl) ResyncRoleBadges(ctx context.Context, dryRun bool) (RoleResyncReport, error) {
+	var report RoleResyncReport
+	if s.roles == nil {
+		return report, ErrNoRoleSource
+	}
+	ctx = WithAudit(ctx, Audit{Actor: ActorRoleSync, Source: "role-resync"})
+
+	badges := roleBadges(s.badgeCfg.Get())
+	if len(badges) == 0 {
+		return report, nil
+	}
+
+	after := uuid.Nil
+	for {
+		players, err := s.repo.GetBadgePlayersAfter(ctx, after, roleResyncBatchSize)
+		if err != nil {
+			return report, fmt.Errorf("failed to get players: %w", err)
+		}
+
+		for _, player := range players {
+			after = player.ID
+
+			playerRoles, err := s.roles.GetPlayerRoles(ctx, player.ID)
+			if err != nil {
+				if ctx.Err() != nil {
+					return report, ctx.Err()
+				}
+				if errors.Is(err, ErrPlayerRolesUnknown) {
+					s.log.Debugw("skipping player unknown to the role source", "playerId", player.ID)
+					report.PlayersSkipped++
+					continue
+				}
+
+				s.log.Warnw("failed to get player roles", "playerId", player.ID, "error", err)
+				report.PlayersFailed++
+				continue
+			}
+			report.PlayersChecked++
+
+			for _, badge := range badges {
+				qualifies := badge.AutomaticGrants.QualifiesForRoles(playerRoles)
+				if qualifies == slices.Contains(player.BadgeIDs, badge.Id) {
+					continue
+				}
+
+				if !dryRun {
+					if err := s.applyRoleBadge(ctx, player.ID, badge.Id, qualifies); err != nil {
+						s.log.Errorw("failed to update role badge", "playerId", player.ID, "badgeId", badge.Id, "error", err)
+						continue
+					}
+				}
+
+				report.Changes = append(report.Changes, RoleBadgeChange{PlayerID: player.ID, BadgeID: badge.Id, Added: qualifies})
+			}
+		}
+
+		if len(players) < roleResyncBatchSize {
+			return report, nil
+		}
+	}
+}
+
+func (s *serviceImpl) applyRoleBadge(ctx context.Context, playerID uuid.UUID, badgeID string, add bool) error {
+	if add {
+		if err := s.AddBadgeToPlayer(ctx, playerID, badgeID); err != nil && !errors.Is(err, AlreadyHasBadgeErr) {
+			return err
+		}
+		return nil
+	}
+
+	if err := s.RemoveBadgeFromPlayer(ctx, playerID, badgeID); err != nil && !errors.Is(err, DoesntHaveBadgeErr) {
+		return err
+	}
+	return nil
+}
+
+// roleBadges returns every badge that is automatically granted for permission roles
+func roleBadges(cfg *config.BadgeConfig) []*config.Badge {
+	var badges []*config.Badge
+	for _, b := range cfg.Badges {
+		if len(b.AutomaticGrants.Roles()) > 0 {
+			badges = append(badges, b)
+		}
+	}
+
+	return badges
+}
+
+// badgesForRole returns every badge that is automatically granted for the role
+func badgesForRole(cfg *config.BadgeConfig, roleID string) []*config.Badge {
+	var badges []*config.Badge
+	for _, b := range cfg.Badges {
+		if slices.Contains(b.AutomaticGrants.Roles(), roleID) {
+			badges = append(badges, b)
+		}
+	}
+
+	return badges
+}
@@ -0,0 +1,145 @@
+package badge
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"mc-player-service/internal/repository/model"
+	"slices"
+	"testing"
+)
+
+func TestResyncRoleBadges(t *testing.T) {
+	staff := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	former := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	unknown := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	failing := uuid.MustParse("00000000-0000-0000-0000-000000000004")
+
+	tests := []struct {
+		name   string
+		dryRun bool
+
+		wantChanges []RoleBadgeChange
+		// wantBadges the badges each player owns afterwards
+		wantBadges map[uuid.UUID][]string
+	}{
+		{
+			name:   "dry run",
+			dryRun: true,
+			wantChanges: []RoleBadgeChange{
+				{PlayerID: staff, BadgeID: "staff", Added: true},
+				{PlayerID: former, BadgeID: "staff", Added: false},
+			},
+			wantBadges: map[uuid.UUID][]string{
+				staff:   nil,
+				former:  {"staff"},
+				unknown: {"staff"},
+				failing: {"staff"},
+			},
+		},
+		{
+			name: "apply",
+			wantChanges: []RoleBadgeChange{
+				{PlayerID: staff, BadgeID: "staff", Added: true},
+				{PlayerID: former, BadgeID: "staff", Added: false},
+			},
+			wantBadges: map[uuid.UUID][]string{
+				staff:   {"staff"},
+				former:  {},
+				unknown: {"staff"},
+				failing: {"staff"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repo := newFakeRepo(
+				model.Player{ID: staff},
+				model.Player{ID: former, Badges: []string{"staff"}},
+				model.Player{ID: unknown, Badges: []string{"staff"}},
+				model.Player{ID: failing, Badges: []string{"staff"}},
+			)
+			roles := failingRoleSource{
+				StaticRoleSource: StaticRoleSource{
+					staff:   {"moderator"},
+					former:  {},
+					failing: {},
+				},
+				failing: []uuid.UUID{failing},
+			}
+			svc := newTestService(t, repo, roles, roleBadge("staff", false, "moderator", "admin"))
+
+			report, err := svc.ResyncRoleBadges(context.Background(), test.dryRun)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if report.PlayersChecked != 2 {
+				t.Errorf("PlayersChecked = %d, want 2", report.PlayersChecked)
+			}
+			// A player the role source doesn't know must keep their badges rather than be treated as having no roles
+			if report.PlayersSkipped != 1 {
+				t.Errorf("PlayersSkipped = %d, want 1", report.PlayersSkipped)
+			}
+			if report.PlayersFailed != 1 {
+				t.Errorf("PlayersFailed = %d, want 1", report.PlayersFailed)
+			}
+			if !slices.Equal(report.Changes, test.wantChanges) {
+				t.Errorf("Changes = %v, want %v", report.Changes, test.wantChanges)
+			}
+
+			for playerID, want := range test.wantBadges {
+				if got := repo.badges(playerID); !slices.Equal(got, want) {
+					t.Errorf("player %s badges = %v, want %v", playerID, got, want)
+				}
+			}
+			if test.dryRun && len(repo.audits) != 0 {
+				t.Errorf("dry run recorded %d audit records", len(repo.audits))
+			}
+		})
+	}
+}
+
+func TestResyncRoleBadgesBatches(t *testing.T) {
+	players := make([]model.Player, roleResyncBatchSize*2+1)
+	roles := make(StaticRoleSource, len(players))
+	for i := range players {
+		players[i].ID = uuid.New()
+		roles[players[i].ID] = []string{"moderator"}
+	}
+
+	repo := newFakeRepo(players...)
+	svc := newTestService(t, repo, roles, roleBadge("staff", false, "moderator"))
+
+	report, err := svc.ResyncRoleBadges(context.Background(), false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if report.PlayersChecked != len(players) {
+		t.Errorf("PlayersChecked = %d, want %d", report.PlayersChecked, len(players))
+	}
+	if len(report.Changes) != len(players) {
+		t.Errorf("len(Changes) = %d, want %d", len(report.Changes), len(players))
+	}
+	if len(repo.batches) != 3 {
+		t.Fatalf("got %d batches, want 3", len(repo.batches))
+	}
+	if repo.batches[0] != uuid.Nil {
+		t.Errorf("first batch started after %s, want the nil UUID", repo.batches[0])
+	}
+	for i := 1; i < len(repo.batches); i++ {
+		if !uuidLess(repo.batches[i-1], repo.batches[i]) {
+			t.Errorf("batch %d started after %s, which isn't after the previous batch", i, repo.batches[i])
+		}
+	}
+}
+
+func TestResyncRoleBadgesWithoutRoleSource(t *testing.T) {
+	svc := newTestService(t, newFakeRepo(), nil, roleBadge("staff", false, "moderator"))
+
+	if _, err := svc.ResyncRoleBadges(context.Background(), false); !errors.Is(err, ErrNoRoleSource) {
+		t.Errorf("err = %v, want %v", err, ErrNoRoleSource)
+	}
+}
@@ -5,31 +5,75 @@ import (
 	"errors"
 	"fmt"
 	permmsg "github.com/emortalmc/proto-specs/gen/go/message/permission"
+	pbmodel "github.com/emortalmc/proto-specs/gen/go/model/badge"
+	"github.com/emortalmc/proto-specs/gen/go/model/common"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"mc-player-service/internal/config"
 	"mc-player-service/internal/repository"
+	"mc-player-service/internal/repository/model"
+	"slices"
+	"time"
 )
 
 type Service interface {
 	// AddBadgeToPlayer adds a badge to a player and updates
-	// their active badge if necessary
+	// their active badge if necessary. Lower tiers of the badge in its group are removed.
 	AddBadgeToPlayer(ctx context.Context, playerId uuid.UUID, badgeId string) error
 
+	// AddTemporaryBadgeToPlayer functions like AddBadgeToPlayer but the badge is removed by ExpireBadges once expiresAt has passed
+	AddTemporaryBadgeToPlayer(ctx context.Context, playerID uuid.UUID, badgeID string, expiresAt time.Time) error
+
+	// ExpireBadges removes every badge grant that has expired, updating the active badge of players whose
+	// active badge expired. The number of badges removed is returned.
+	ExpireBadges(ctx context.Context) (int, error)
+
+	// GetPlayerBadgeGrants returns a grant for each badge the player owns
+	GetPlayerBadgeGrants(ctx context.Context, playerID uuid.UUID) ([]model.BadgeGrant, error)
+
 	// RemoveBadgeFromPlayer removes a player's badge and updates
 	// their active badge if necessary
 	RemoveBadgeFromPlayer(ctx context.Context, playerId uuid.UUID, badgeId string) error
 
-	// UpdateActiveBadge updates a player's active badge based on the badge
-	// they own and their priority. If the player no longer owns their active badge
-	// and none are required, the active badge will be set to nil
+	// UpdateActiveBadge updates a player's active badge based on the badges
+	// they own and their priority. A badge the player chose is kept unless they
+	// no longer own it or they own a forced badge.
 	UpdateActiveBadge(ctx context.Context, playerId uuid.UUID) error
 
-	// SetActiveBadge sets a player's active badge to the given badge
+	// SetActiveBadge sets a player's active badge to the badge they chose, or no badge if badgeID is empty.
+	// ErrForcedBadgeOwned is returned if the player owns a forced badge and the badge isn't forced.
 	SetActiveBadge(ctx context.Context, playerID uuid.UUID, badgeID string) error
 
+	// ResetActiveBadge forgets the badge the player chose, so their active badge is calculated automatically again
+	ResetActiveBadge(ctx context.Context, playerID uuid.UUID) error
+
+	// ApplyConfig swaps in a reloaded config and recomputes the active badge of every player
+	// owning a badge whose priority or required flag changed, returning the number of players updated
+	ApplyConfig(ctx context.Context, cfg config.BadgeConfig) (int, error)
+
+	// ResyncRoleBadges adds and removes permission role badges so that every player owns exactly
+	// the role badges their current roles qualify them for. Nothing is changed if dryRun is true.
+	// ErrNoRoleSource is returned if the service has no RoleSource.
+	ResyncRoleBadges(ctx context.Context, dryRun bool) (RoleResyncReport, error)
+
+	// EvaluateBadgeCriteria grants the player every badge whose criteria they meet, and revokes badges
+	// that are set to be revoked if the player no longer meets their criteria
+	EvaluateBadgeCriteria(ctx context.Context, playerID uuid.UUID) error
+
+	// SweepBadgeCriteria evaluates the badge criteria of every player
+	SweepBadgeCriteria(ctx context.Context) (CriteriaSweepReport, error)
+
+	// GetBadgeGroups returns every badge group ordered by ID
+	GetBadgeGroups() []*config.BadgeGroup
+
+	// GetBadgeStats returns how many players own each badge without waiting for them to be computed.
+	// The stats are refreshed in the background so may be a few minutes old, and ErrStatsNotReady
+	// is returned until they have been computed for the first time.
+	GetBadgeStats(ctx context.Context) (Stats, error)
+
+	// GetAuditRecords returns badge audit records newest first, filtered by player and badge if not nil
+	GetAuditRecords(ctx context.Context, playerID *uuid.UUID, badgeID *string, pageable *common.Pageable) ([]model.BadgeAuditRecord, *common.PageData, error)
+
 	// Kafka Handlers
 
 	HandlePlayerRolesUpdate(ctx context.Context, playerID uuid.UUID, roleID string,
@@ -41,17 +85,27 @@ type serviceImpl struct {
 
 	repo         repository.BadgeReadWriter
 	playerReader repository.PlayerReader
-	badgeCfg     config.BadgeConfig
+	badgeCfg     *config.BadgeConfigHolder
+	notif        KafkaWriter
+	// roles may be nil if the permission service isn't configured
+	roles RoleSource
+
+	statsCache statsCache
 }
 
+// NewService creates a badge service, which notifies notif whenever a badge is added, removed or activated.
+// roles may be nil, in which case role badges can't be resynced
+// and role updates are handled using only the role in the update.
 func NewService(log *zap.SugaredLogger, badgeRepo repository.BadgeReadWriter, playerReader repository.PlayerReader,
-	badgeCfg config.BadgeConfig) Service {
+	badgeCfg *config.BadgeConfigHolder, notif KafkaWriter, roles RoleSource) Service {
 	return &serviceImpl{
 		log: log,
 
 		repo:         badgeRepo,
 		playerReader: playerReader,
 		badgeCfg:     badgeCfg,
+		notif:        notif,
+		roles:        roles,
 	}
 }
 
@@ -61,12 +115,27 @@ var (
 )
 
 func (s *serviceImpl) AddBadgeToPlayer(ctx context.Context, playerId uuid.UUID, badgeId string) error {
-	badge, ok := s.badgeCfg.Badges[badgeId]
+	if err := s.addBadge(ctx, playerId, badgeId, nil); err != nil {
+		return err
+	}
+
+	s.evaluateDependentCriteria(ctx, playerId, badgeId)
+	return nil
+}
+
+func (s *serviceImpl) addBadge(ctx context.Context, playerId uuid.UUID, badgeId string, expiresAt *time.Time) error {
+	badgeCfg := s.badgeCfg.Get()
+	badge, ok := badgeCfg.Badges[badgeId]
 	if !ok {
 		return DoesntExistErr
 	}
 
-	modCount, err := s.repo.AddPlayerBadge(ctx, playerId, badgeId)
+	lowerTiers, err := s.getLowerTiers(ctx, badgeCfg, playerId, badgeId)
+	if err != nil {
+		return err
+	}
+
+	modCount, err := s.repo.AddPlayerBadge(ctx, playerId, badgeId, expiresAt)
 	if err != nil {
 		return fmt.Errorf("failed to add player badge: %w", err)
 	}
@@ -74,8 +143,16 @@ func (s *serviceImpl) AddBadgeToPlayer(ctx context.Context, playerId uuid.UUID,
 	if modCount == 0 {
 		return AlreadyHasBadgeErr
 	}
+	s.recordAudit(ctx, playerId, badgeId, AuditActionGrant)
+	s.notif.PlayerBadgeAdded(ctx, playerId, badge.ToProto())
+
+	for _, lowerID := range lowerTiers {
+		if err := s.removeBadge(ctx, playerId, lowerID); err != nil && !errors.Is(err, DoesntHaveBadgeErr) {
+			return fmt.Errorf("failed to remove lower tier badge %s: %w", lowerID, err)
+		}
+	}
 
-	if badge.Required {
+	if badge.Required || badge.Forced {
 		if err := s.UpdateActiveBadge(ctx, playerId); err != nil {
 			return fmt.Errorf("failed to update active badge: %w", err)
 		}
@@ -84,11 +161,54 @@ func (s *serviceImpl) AddBadgeToPlayer(ctx context.Context, playerId uuid.UUID,
 	return nil
 }
 
+// HigherTierOwnedErr is returned when granting a badge if the player already owns a higher tier in its group.
+// It wraps AlreadyHasBadgeErr as the player already has the badge or better.
+var HigherTierOwnedErr = fmt.Errorf("%w: player has a higher tier of this badge", AlreadyHasBadgeErr)
+
+// getLowerTiers returns the badges the player owns in the same group as the badge that are lower tiers,
+// so should be replaced by it. HigherTierOwnedErr is returned if the player owns a higher tier.
+func (s *serviceImpl) getLowerTiers(ctx context.Context, badgeCfg *config.BadgeConfig, playerID uuid.UUID, badgeID string) ([]string, error) {
+	group, tier, ok := badgeCfg.GroupOf(badgeID)
+	if !ok {
+		return nil, nil
+	}
+
+	player, err := s.repo.GetBadgePlayer(ctx, playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player badge: %w", err)
+	}
+
+	var lower []string
+	for i, groupBadgeID := range group.Badges {
+		if !slices.Contains(player.BadgeIDs, groupBadgeID) {
+			continue
+		}
+
+		if i > tier {
+			return nil, HigherTierOwnedErr
+		}
+		if i < tier {
+			lower = append(lower, groupBadgeID)
+		}
+	}
+
+	return lower, nil
+}
+
 var (
 	DoesntHaveBadgeErr = errors.New("player does not have this badge")
 )
 
 func (s *serviceImpl) RemoveBadgeFromPlayer(ctx context.Context, playerId uuid.UUID, badgeId string) error {
+	if err := s.removeBadge(ctx, playerId, badgeId); err != nil {
+		return err
+	}
+
+	s.evaluateDependentCriteria(ctx, playerId, badgeId)
+	return nil
+}
+
+func (s *serviceImpl) removeBadge(ctx context.Context, playerId uuid.UUID, badgeId string) error {
 	player, err := s.playerReader.GetPlayer(ctx, playerId)
 	if err != nil {
 		return fmt.Errorf("failed to get player: %w", err)
@@ -107,14 +227,18 @@ func (s *serviceImpl) RemoveBadgeFromPlayer(ctx context.Context, playerId uuid.U
 	}
 
 	s.log.Debugw("checking active badge", "activeBadge", player.ActiveBadge, "badgeId", badgeId)
-	if player.ActiveBadge != nil && *player.ActiveBadge == badgeId {
-		player.ActiveBadge = s.calculateActiveBadge(player.Badges)
-	}
+	activeBadge, manual := s.resolveActiveBadge(player.Badges, player.ActiveBadge, player.ActiveBadgeManual)
+	activeChanged := !equalBadgeIDs(player.ActiveBadge, activeBadge)
 
-	s.log.Debugw("Updating badge", "playerId", playerId, "badges", player.Badges, "activeBadge", player.ActiveBadge)
-	if err := s.repo.UpdatePlayerBadgesAndActive(ctx, playerId, player.Badges, player.ActiveBadge); err != nil {
+	s.log.Debugw("Updating badge", "playerId", playerId, "badges", player.Badges, "activeBadge", activeBadge)
+	if err := s.repo.UpdatePlayerBadgesAndActive(ctx, playerId, player.Badges, activeBadge, manual); err != nil {
 		return fmt.Errorf("failed to update player: %w", err)
 	}
+	s.recordAudit(ctx, playerId, badgeId, AuditActionRevoke)
+	s.notif.PlayerBadgeRemoved(ctx, playerId, s.badgeProto(badgeId))
+	if activeChanged {
+		s.notifyActiveBadgeChanged(ctx, playerId, activeBadge)
+	}
 
 	return nil
 }
@@ -125,54 +249,160 @@ func (s *serviceImpl) UpdateActiveBadge(ctx context.Context, playerId uuid.UUID)
 		return fmt.Errorf("failed to get player badge: %w", err)
 	}
 
-	highestBadgeId := s.calculateActiveBadge(player.BadgeIDs)
+	activeBadge, manual := s.resolveActiveBadge(player.BadgeIDs, player.ActiveBadge, player.ActiveBadgeManual)
+	return s.setActiveBadge(ctx, player, activeBadge, manual)
+}
+
+// ErrForcedBadgeOwned is returned when a player chooses a badge that isn't forced while owning a forced badge
+var ErrForcedBadgeOwned = errors.New("player owns a forced badge")
 
-	if err := s.repo.SetActivePlayerBadge(ctx, playerId, highestBadgeId); err != nil {
-		return fmt.Errorf("failed to set active badge: %w", err)
+func (s *serviceImpl) SetActiveBadge(ctx context.Context, playerID uuid.UUID, badgeID string) error {
+	player, err := s.repo.GetBadgePlayer(ctx, playerID)
+	if err != nil {
+		return fmt.Errorf("failed to get player badge: %w", err)
 	}
 
+	var activeBadge *string
+	if badgeID != "" {
+		if !slices.Contains(player.BadgeIDs, badgeID) {
+			return DoesntHaveBadgeErr
+		}
+		activeBadge = &badgeID
+	}
+
+	if s.calculateActiveBadge(player.BadgeIDs, true) != nil && (activeBadge == nil || !s.isForced(badgeID)) {
+		return ErrForcedBadgeOwned
+	}
+
+	if err := s.setActiveBadge(ctx, player, activeBadge, true); err != nil {
+		return err
+	}
+	// An empty badge ID records that the player chose to show no badge
+	s.recordAudit(ctx, playerID, badgeID, AuditActionSetActive)
+
 	return nil
 }
 
-var ErrPlayerDoesntHaveBadge = status.Error(codes.NotFound, "player does not have this badge")
-
-func (s *serviceImpl) SetActiveBadge(ctx context.Context, playerID uuid.UUID, badgeID string) error {
+func (s *serviceImpl) ResetActiveBadge(ctx context.Context, playerID uuid.UUID) error {
 	player, err := s.repo.GetBadgePlayer(ctx, playerID)
 	if err != nil {
 		return fmt.Errorf("failed to get player badge: %w", err)
 	}
 
-	for _, loopBadgeID := range player.BadgeIDs {
-		if loopBadgeID == badgeID {
-			if err := s.repo.SetActivePlayerBadge(ctx, playerID, &badgeID); err != nil {
-				return fmt.Errorf("failed to set active badge: %w", err)
-			}
+	activeBadge, _ := s.resolveActiveBadge(player.BadgeIDs, player.ActiveBadge, false)
+	if err := s.setActiveBadge(ctx, player, activeBadge, false); err != nil {
+		return err
+	}
+
+	var badgeID string
+	if activeBadge != nil {
+		badgeID = *activeBadge
+	}
+	s.recordAudit(ctx, playerID, badgeID, AuditActionResetActive)
+
+	return nil
+}
+
+// setActiveBadge saves the player's active badge if it or whether it was chosen changed
+func (s *serviceImpl) setActiveBadge(ctx context.Context, player model.BadgePlayer, activeBadge *string, manual bool) error {
+	activeChanged := !equalBadgeIDs(player.ActiveBadge, activeBadge)
+	if !activeChanged && player.ActiveBadgeManual == manual {
+		return nil
+	}
+
+	if err := s.repo.SetActivePlayerBadge(ctx, player.ID, activeBadge, manual); err != nil {
+		return fmt.Errorf("failed to set active badge: %w", err)
+	}
+	if activeChanged {
+		s.notifyActiveBadgeChanged(ctx, player.ID, activeBadge)
+	}
+
+	return nil
+}
 
-			return nil
+// resolveActiveBadge returns the badge that should be active and whether the player chose it.
+// The badge the player chose (including no badge) is kept if they still own it and either it's forced
+// or they don't own a forced badge. Otherwise, the highest priority forced badge is active,
+// falling back to the highest priority badge.
+func (s *serviceImpl) resolveActiveBadge(badgeIDs []string, current *string, manual bool) (*string, bool) {
+	forced := s.calculateActiveBadge(badgeIDs, true)
+
+	if manual {
+		if current == nil && forced == nil {
+			return nil, true
+		}
+
+		if current != nil && slices.Contains(badgeIDs, *current) {
+			badge, ok := s.badgeCfg.Get().Badges[*current]
+			if ok && (forced == nil || badge.Forced) {
+				return current, true
+			}
 		}
 	}
 
-	return ErrPlayerDoesntHaveBadge
+	if forced != nil {
+		return forced, false
+	}
+
+	return s.calculateActiveBadge(badgeIDs, false), false
+}
+
+func (s *serviceImpl) isForced(badgeID string) bool {
+	badge, ok := s.badgeCfg.Get().Badges[badgeID]
+	return ok && badge.Forced
 }
 
-func (s *serviceImpl) calculateActiveBadge(badgeIDs []string) *string {
+// calculateActiveBadge returns the owned badge with the highest priority, only considering forced badges if forcedOnly
+func (s *serviceImpl) calculateActiveBadge(badgeIDs []string, forcedOnly bool) *string {
 	var highestBadgeId *string
 	var highestBadgePriority int
 
-	for _, badgeId := range badgeIDs {
-		badge, ok := s.badgeCfg.Badges[badgeId]
+	badgeCfg := s.badgeCfg.Get()
+	for i, badgeId := range badgeIDs {
+		badge, ok := badgeCfg.Badges[badgeId]
 		if !ok {
 			s.log.Warnw("player has badge that does not exist", "badgeId", badgeId)
 			continue
 		}
 
+		if forcedOnly && !badge.Forced {
+			continue
+		}
+
 		if badge.Priority > highestBadgePriority {
-			highestBadgeId = &badgeId
+			highestBadgeId = &badgeIDs[i]
 			highestBadgePriority = badge.Priority
 		}
 	}
 
-	s.log.Debugw("Calculated active badge", "badgeIDs", badgeIDs, "activeBadge", highestBadgeId)
+	s.log.Debugw("Calculated active badge", "badgeIDs", badgeIDs, "forcedOnly", forcedOnly, "activeBadge", highestBadgeId)
 
 	return highestBadgeId
 }
+
+func (s *serviceImpl) notifyActiveBadgeChanged(ctx context.Context, playerID uuid.UUID, activeBadge *string) {
+	var badge *pbmodel.Badge
+	if activeBadge != nil {
+		badge = s.badgeProto(*activeBadge)
+	}
+
+	s.notif.PlayerActiveBadgeChanged(ctx, playerID, badge)
+}
+
+// badgeProto returns the proto of the badge, or one with only the ID set if it's no longer in the config
+func (s *serviceImpl) badgeProto(badgeID string) *pbmodel.Badge {
+	badge, ok := s.badgeCfg.Get().Badges[badgeID]
+	if !ok {
+		return &pbmodel.Badge{Id: badgeID}
+	}
+
+	return badge.ToProto()
+}
+
+func equalBadgeIDs(a *string, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
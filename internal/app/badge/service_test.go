@@ -0,0 +1,205 @@
+package badge
+
+import (
+	"context"
+	"errors"
+	pbmodel "github.com/emortalmc/proto-specs/gen/go/model/badge"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+	"mc-player-service/internal/config"
+	"mc-player-service/internal/repository"
+	"mc-player-service/internal/repository/model"
+	"slices"
+	"sort"
+	"testing"
+	"time"
+)
+
+// fakeRepo keeps players in memory. Only the methods the tests use are implemented,
+// calling any other panics through the nil embedded interfaces.
+type fakeRepo struct {
+	repository.BadgeReadWriter
+	repository.PlayerReader
+
+	players map[uuid.UUID]*model.Player
+	audits  []model.BadgeAuditRecord
+	// batches the after ID of each GetBadgePlayersAfter call
+	batches []uuid.UUID
+}
+
+func newFakeRepo(players ...model.Player) *fakeRepo {
+	r := &fakeRepo{players: make(map[uuid.UUID]*model.Player, len(players))}
+	for i := range players {
+		r.players[players[i].ID] = &players[i]
+	}
+
+	return r
+}
+
+func (r *fakeRepo) badges(playerID uuid.UUID) []string {
+	player, ok := r.players[playerID]
+	if !ok {
+		return nil
+	}
+
+	return player.Badges
+}
+
+func (r *fakeRepo) GetPlayer(_ context.Context, id uuid.UUID) (model.Player, error) {
+	player, ok := r.players[id]
+	if !ok {
+		return model.Player{}, mongo.ErrNoDocuments
+	}
+
+	copied := *player
+	copied.Badges = slices.Clone(player.Badges)
+	return copied, nil
+}
+
+func (r *fakeRepo) GetBadgePlayer(ctx context.Context, id uuid.UUID) (model.BadgePlayer, error) {
+	player, err := r.GetPlayer(ctx, id)
+	if err != nil {
+		return model.BadgePlayer{}, err
+	}
+
+	return model.BadgePlayer{ID: player.ID, BadgeIDs: player.Badges, ActiveBadge: player.ActiveBadge,
+		ActiveBadgeManual: player.ActiveBadgeManual}, nil
+}
+
+func (r *fakeRepo) GetBadgePlayersAfter(ctx context.Context, after uuid.UUID, limit int) ([]model.BadgePlayer, error) {
+	r.batches = append(r.batches, after)
+
+	ids := make([]uuid.UUID, 0, len(r.players))
+	for id := range r.players {
+		if uuidLess(after, id) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return uuidLess(ids[i], ids[j]) })
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+
+	players := make([]model.BadgePlayer, len(ids))
+	for i, id := range ids {
+		players[i], _ = r.GetBadgePlayer(ctx, id)
+	}
+
+	return players, nil
+}
+
+func (r *fakeRepo) AddPlayerBadge(_ context.Context, playerID uuid.UUID, badgeID string, _ *time.Time) (int64, error) {
+	player, ok := r.players[playerID]
+	if !ok || slices.Contains(player.Badges, badgeID) {
+		return 0, nil
+	}
+
+	player.Badges = append(player.Badges, badgeID)
+	return 1, nil
+}
+
+func (r *fakeRepo) UpdatePlayerBadgesAndActive(_ context.Context, playerID uuid.UUID, badges []string,
+	activeBadge *string, manual bool) error {
+
+	player, ok := r.players[playerID]
+	if !ok {
+		return mongo.ErrNoDocuments
+	}
+
+	player.Badges = slices.Clone(badges)
+	player.ActiveBadge = activeBadge
+	player.ActiveBadgeManual = manual
+	return nil
+}
+
+func (r *fakeRepo) SetActivePlayerBadge(_ context.Context, playerID uuid.UUID, badgeID *string, manual bool) error {
+	player, ok := r.players[playerID]
+	if !ok {
+		return mongo.ErrNoDocuments
+	}
+
+	player.ActiveBadge = badgeID
+	player.ActiveBadgeManual = manual
+	return nil
+}
+
+func (r *fakeRepo) CreateBadgeAuditRecord(_ context.Context, record model.BadgeAuditRecord) error {
+	r.audits = append(r.audits, record)
+	return nil
+}
+
+func uuidLess(a uuid.UUID, b uuid.UUID) bool {
+	return slices.Compare(a[:], b[:]) < 0
+}
+
+type noopKafkaWriter struct{}
+
+func (noopKafkaWriter) PlayerBadgeAdded(context.Context, uuid.UUID, *pbmodel.Badge)         {}
+func (noopKafkaWriter) PlayerBadgeRemoved(context.Context, uuid.UUID, *pbmodel.Badge)       {}
+func (noopKafkaWriter) PlayerActiveBadgeChanged(context.Context, uuid.UUID, *pbmodel.Badge) {}
+
+var errRolesUnavailable = errors.New("permission service unavailable")
+
+// failingRoleSource fails to get the roles of the players in failing, falling back to StaticRoleSource for the rest
+type failingRoleSource struct {
+	StaticRoleSource
+	failing []uuid.UUID
+}
+
+func (r failingRoleSource) GetPlayerRoles(ctx context.Context, playerID uuid.UUID) ([]string, error) {
+	if slices.Contains(r.failing, playerID) {
+		return nil, errRolesUnavailable
+	}
+
+	return r.StaticRoleSource.GetPlayerRoles(ctx, playerID)
+}
+
+func newTestService(t *testing.T, repo *fakeRepo, roles RoleSource, badges ...*config.Badge) *serviceImpl {
+	t.Helper()
+
+	cfg := config.BadgeConfig{Badges: make(map[string]*config.Badge, len(badges))}
+	for i, badge := range badges {
+		badge.Priority = i + 1
+		badge.GuiItem = &config.BadgeGuiItem{Material: "minecraft:stone"}
+		cfg.Badges[badge.Id] = badge
+	}
+
+	return NewService(zap.NewNop().Sugar(), repo, repo, config.NewBadgeConfigHolder(cfg), noopKafkaWriter{}, roles).(*serviceImpl)
+}
+
+// roleBadge a badge granted for any (or all if matchAll) of the roles
+func roleBadge(id string, matchAll bool, roles ...string) *config.Badge {
+	grants := &config.BadgeAutomaticGrants{PermissionRoles: roles}
+	if matchAll {
+		grants.PermissionRoleMatch = config.RoleMatchAll
+	}
+
+	return &config.Badge{Id: id, AutomaticGrants: grants}
+}
+
+func TestResetActiveBadgeRecordsAudit(t *testing.T) {
+	playerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	chosen := "low"
+
+	repo := newFakeRepo(model.Player{ID: playerID, Badges: []string{"low", "high"}, ActiveBadge: &chosen, ActiveBadgeManual: true})
+	svc := newTestService(t, repo, nil, &config.Badge{Id: "low"}, &config.Badge{Id: "high"})
+
+	ctx := WithAudit(context.Background(), Audit{Actor: "admin", Reason: "support ticket"})
+	if err := svc.ResetActiveBadge(ctx, playerID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	player := repo.players[playerID]
+	if player.ActiveBadge == nil || *player.ActiveBadge != "high" || player.ActiveBadgeManual {
+		t.Errorf("active badge = %v (manual %t), want high", player.ActiveBadge, player.ActiveBadgeManual)
+	}
+
+	if len(repo.audits) != 1 {
+		t.Fatalf("got %d audit records, want 1", len(repo.audits))
+	}
+	audit := repo.audits[0]
+	if audit.Action != AuditActionResetActive || audit.BadgeID != "high" || audit.Actor != "admin" || audit.Reason != "support ticket" {
+		t.Errorf("audit = %+v", audit)
+	}
+}
@@ -0,0 +1,101 @@
+package badge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	statsCacheDuration = 5 * time.Minute
+	// statsRetryInterval how long to wait before computing the stats again after it failed
+	statsRetryInterval = 30 * time.Second
+)
+
+type Stats struct {
+	TotalPlayers int64
+	// Owners the number of players that own each configured badge
+	Owners     map[string]int64
+	ComputedAt time.Time
+}
+
+// Rarity returns the percentage of all players that own the badge
+func (s Stats) Rarity(badgeID string) float64 {
+	if s.TotalPlayers == 0 {
+		return 0
+	}
+
+	return float64(s.Owners[badgeID]) / float64(s.TotalPlayers) * 100
+}
+
+// ErrStatsNotReady the stats are still being computed for the first time
+var ErrStatsNotReady = errors.New("badge stats have not been computed yet")
+
+type statsCache struct {
+	sync.Mutex
+
+	stats      Stats
+	refreshing bool
+	// attemptedAt when the last refresh started, so that failing refreshes aren't retried on every call
+	attemptedAt time.Time
+}
+
+func (s *serviceImpl) GetBadgeStats(ctx context.Context) (Stats, error) {
+	s.statsCache.Lock()
+	defer s.statsCache.Unlock()
+
+	// Stale stats are returned while they're refreshed in the background, as computing them can take a while
+	stats := s.statsCache.stats
+	if time.Since(stats.ComputedAt) >= statsCacheDuration && !s.statsCache.refreshing &&
+		time.Since(s.statsCache.attemptedAt) >= statsRetryInterval {
+
+		s.statsCache.refreshing = true
+		s.statsCache.attemptedAt = time.Now()
+		go s.refreshStats(context.WithoutCancel(ctx))
+	}
+
+	if stats.ComputedAt.IsZero() {
+		return Stats{}, ErrStatsNotReady
+	}
+
+	return stats, nil
+}
+
+func (s *serviceImpl) refreshStats(ctx context.Context) {
+	stats, err := s.computeStats(ctx)
+
+	s.statsCache.Lock()
+	defer s.statsCache.Unlock()
+
+	s.statsCache.refreshing = false
+	if err != nil {
+		s.log.Errorw("failed to compute badge stats", "error", err)
+		return
+	}
+	s.statsCache.stats = stats
+}
+
+func (s *serviceImpl) computeStats(ctx context.Context) (Stats, error) {
+	counts, err := s.repo.GetBadgeOwnerCounts(ctx)
+	if err != nil {
+		return Stats{}, fmt.Errorf("failed to get badge owner counts: %w", err)
+	}
+
+	total, err := s.playerReader.GetTotalUniquePlayers(ctx)
+	if err != nil {
+		return Stats{}, fmt.Errorf("failed to get total players: %w", err)
+	}
+
+	stats := Stats{
+		TotalPlayers: total,
+		Owners:       make(map[string]int64, len(counts)),
+		ComputedAt:   time.Now(),
+	}
+	for id := range s.badgeCfg.Get().Badges {
+		stats.Owners[id] = counts[id]
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,154 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go.uber.org/zap"
+	"mc-player-service/internal/app/badge"
+	"mc-player-service/internal/app/catalogue"
+	"mc-player-service/internal/config"
+	kafkaConsumer "mc-player-service/internal/kafka/consumer"
+	"mc-player-service/internal/repository"
+	"sync"
+	"time"
+)
+
+const defaultCatalogueReloadInterval = 5 * time.Minute
+
+// badgeConfigLoader builds the badge config from the badge config file and, if enabled, the badge catalogue
+type badgeConfigLoader struct {
+	log *zap.SugaredLogger
+	// catalogue is nil if badges are read from the badge config file
+	catalogue catalogue.Service
+
+	mu          sync.Mutex
+	fileCfg     config.BadgeConfig
+	reloadCount int
+}
+
+func newBadgeConfigLoader(log *zap.SugaredLogger, catalogueSvc catalogue.Service) (*badgeConfigLoader, error) {
+	fileCfg, err := config.LoadBadgeConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return &badgeConfigLoader{
+		log:       log,
+		catalogue: catalogueSvc,
+		fileCfg:   fileCfg,
+	}, nil
+}
+
+// loadBadgeConfig loads the validated badge config once, for one-off commands
+func loadBadgeConfig(ctx context.Context, log *zap.SugaredLogger, cfg config.Config, repo repository.BadgeCatalogueReadWriter,
+	notifier catalogue.KafkaWriter) (config.BadgeConfig, error) {
+
+	var catalogueSvc catalogue.Service
+	if cfg.BadgeCatalogue.Source == config.BadgeSourceMongo {
+		catalogueSvc = catalogue.NewService(log, repo, notifier, config.NewBadgeConfigHolder(config.BadgeConfig{}))
+	}
+
+	loader, err := newBadgeConfigLoader(log, catalogueSvc)
+	if err != nil {
+		return config.BadgeConfig{}, err
+	}
+
+	return loader.load(ctx)
+}
+
+// load returns the validated badge config
+func (l *badgeConfigLoader) load(ctx context.Context) (config.BadgeConfig, error) {
+	cfg := l.fileCfg
+	if l.catalogue != nil {
+		var err error
+		if cfg, err = l.catalogue.Load(ctx, cfg); err != nil {
+			return config.BadgeConfig{}, fmt.Errorf("failed to load badge catalogue: %w", err)
+		}
+	}
+
+	if err := cfg.Validate(nil); err != nil {
+		return config.BadgeConfig{}, fmt.Errorf("%w: %w", config.ErrBadgeConfigInvalid, err)
+	}
+
+	return cfg, nil
+}
+
+// loadInitial returns the validated badge config. If the badge catalogue is invalid, the badge config file's
+// badges are used instead so that a bad catalogue change doesn't stop replicas starting. The catalogue
+// is used once it's fixed and reloaded.
+func (l *badgeConfigLoader) loadInitial(ctx context.Context) (config.BadgeConfig, error) {
+	cfg, err := l.load(ctx)
+	if err == nil || l.catalogue == nil || !errors.Is(err, config.ErrBadgeConfigInvalid) {
+		return cfg, err
+	}
+	l.log.Errorw("invalid badge catalogue, falling back to the badge config file", "error", err)
+
+	if err := l.fileCfg.Validate(nil); err != nil {
+		return config.BadgeConfig{}, fmt.Errorf("%w: %w", config.ErrBadgeConfigInvalid, err)
+	}
+	return l.fileCfg, nil
+}
+
+// reload applies the current badge config, replacing the badge config file with fileCfg if it isn't nil.
+// If the config is invalid, the error is logged and the previous config is kept.
+func (l *badgeConfigLoader) reload(ctx context.Context, badgeSvc badge.Service, fileCfg *config.BadgeConfig, trigger string) {
+	if ctx.Err() != nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	previousFileCfg := l.fileCfg
+	if fileCfg != nil {
+		l.fileCfg = *fileCfg
+	}
+
+	cfg, err := l.load(ctx)
+	if err != nil {
+		l.fileCfg = previousFileCfg
+		l.log.Errorw("failed to reload badge config, keeping the previous config", "trigger", trigger, "error", err)
+		return
+	}
+
+	start := time.Now()
+	updatedPlayers, err := badgeSvc.ApplyConfig(ctx, cfg)
+	l.reloadCount++
+	if err != nil {
+		l.log.Errorw("reloaded badge config but failed to update active badges", "trigger", trigger,
+			"reloadCount", l.reloadCount, "error", err)
+		return
+	}
+
+	l.log.Infow("reloaded badge config", "trigger", trigger, "reloadCount", l.reloadCount, "badgeCount", len(cfg.Badges),
+		"updatedPlayers", updatedPlayers, "duration", time.Since(start))
+}
+
+// watch applies every valid change to the badge config file, and to the badge catalogue if enabled, until ctx is cancelled
+func (l *badgeConfigLoader) watch(ctx context.Context, wg *sync.WaitGroup, cfg config.Config, badgeSvc badge.Service) {
+	err := config.WatchBadgeConfig(func(fileCfg config.BadgeConfig) {
+		l.reload(ctx, badgeSvc, &fileCfg, "file")
+	}, func(err error) {
+		l.log.Errorw("failed to reload badge config, keeping the previous config", "trigger", "file", "error", err)
+	})
+	if err != nil {
+		l.log.Errorw("failed to watch badge config, changes will require a restart", "error", err)
+	}
+
+	if l.catalogue == nil {
+		return
+	}
+
+	kafkaConsumer.NewCatalogueListener(ctx, wg, cfg.Kafka, l.log, func(ctx context.Context) {
+		l.reload(ctx, badgeSvc, nil, "catalogue")
+	})
+
+	interval := cfg.BadgeCatalogue.ReloadInterval
+	if interval <= 0 {
+		interval = defaultCatalogueReloadInterval
+	}
+	runPeriodically(ctx, wg, interval, func(ctx context.Context) {
+		l.reload(ctx, badgeSvc, nil, "interval")
+	})
+}
@@ -0,0 +1,20 @@
+package app
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"mc-player-service/internal/app/badgejob"
+	"sync"
+	"time"
+)
+
+// badgeJobPollInterval how often the database is checked for new badge jobs and jobs a stopped replica was running
+const badgeJobPollInterval = 10 * time.Second
+
+func runBadgeJobs(ctx context.Context, wg *sync.WaitGroup, log *zap.SugaredLogger, jobSvc badgejob.Service) {
+	runPeriodically(ctx, wg, badgeJobPollInterval, func(ctx context.Context) {
+		if err := jobSvc.RunJobs(ctx); err != nil && ctx.Err() == nil {
+			log.Errorw("failed to run badge jobs", "error", err)
+		}
+	})
+}
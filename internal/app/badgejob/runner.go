@@ -0,0 +1,149 @@
+package badgejob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+	"mc-player-service/internal/app/badge"
+	"mc-player-service/internal/repository/model"
+	"time"
+)
+
+func (s *serviceImpl) RunJobs(ctx context.Context) error {
+	for {
+		now := time.Now()
+		job, err := s.repo.ClaimBadgeJob(ctx, now, now.Add(jobLease))
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return nil
+			}
+			return fmt.Errorf("failed to claim badge job: %w", err)
+		}
+
+		s.log.Infow("running badge job", "jobId", job.ID, "action", job.Action, "badgeId", job.BadgeID,
+			"processed", job.Progress.Processed, "total", job.Total)
+
+		err = s.runJob(ctx, job)
+		switch {
+		case err == nil:
+		case ctx.Err() != nil:
+			// The job is resumed once its lease expires
+			return ctx.Err()
+		case isPermanentJobError(err):
+			s.log.Errorw("badge job failed", "jobId", job.ID, "error", err)
+			if err := s.repo.FinishBadgeJob(ctx, job, model.BadgeJobStatusFailed, err.Error()); err != nil {
+				s.log.Errorw("failed to mark badge job as failed", "jobId", job.ID, "error", err)
+			}
+		default:
+			// Left running so that it's retried once its lease expires
+			s.log.Errorw("failed to run badge job", "jobId", job.ID, "error", err)
+		}
+	}
+}
+
+// isPermanentJobError returns true if retrying the job won't help
+func isPermanentJobError(err error) bool {
+	return errors.Is(err, badge.DoesntExistErr) || errors.Is(err, badge.ErrExpiryInPast)
+}
+
+func (s *serviceImpl) runJob(ctx context.Context, job model.BadgeJob) error {
+	ctx = badge.WithAudit(ctx, badge.Audit{Actor: job.Actor, Source: "bulk-job", Reason: job.Reason})
+	filter := playerFilter(job)
+
+	for {
+		playerIDs, err := s.repo.GetFilteredPlayerIDsAfter(ctx, filter, job.LastPlayerID, jobBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to get players: %w", err)
+		}
+
+		var changed []uuid.UUID
+		for _, playerID := range playerIDs {
+			ok, err := s.applyJob(ctx, job, playerID)
+			if err != nil {
+				if ctx.Err() != nil || isPermanentJobError(err) {
+					return err
+				}
+
+				s.log.Errorw("failed to apply badge job to player", "jobId", job.ID, "playerId", playerID, "error", err)
+				job.Progress.Failed++
+			} else if ok {
+				changed = append(changed, playerID)
+				job.Progress.Changed++
+			} else {
+				job.Progress.Skipped++
+			}
+
+			job.Progress.Processed++
+			job.LastPlayerID = playerID
+		}
+
+		// Revoking already updates the active badge as it's removed
+		if job.Action == model.BadgeJobActionGrant {
+			s.updateActiveBadges(ctx, changed)
+		}
+
+		if len(playerIDs) > 0 {
+			running, err := s.repo.UpdateBadgeJobProgress(ctx, job, time.Now().Add(jobLease))
+			if err != nil {
+				return fmt.Errorf("failed to save badge job progress: %w", err)
+			}
+			if !running {
+				s.log.Infow("stopping badge job as it was cancelled or another replica took it over", "jobId", job.ID,
+					"processed", job.Progress.Processed)
+				return nil
+			}
+
+			s.log.Debugw("badge job progress", "jobId", job.ID, "processed", job.Progress.Processed, "total", job.Total)
+		}
+
+		if len(playerIDs) < jobBatchSize {
+			break
+		}
+	}
+
+	if err := s.repo.FinishBadgeJob(ctx, job, model.BadgeJobStatusCompleted, ""); err != nil {
+		return fmt.Errorf("failed to complete badge job: %w", err)
+	}
+
+	s.log.Infow("completed badge job", "jobId", job.ID, "processed", job.Progress.Processed, "changed", job.Progress.Changed,
+		"skipped", job.Progress.Skipped, "failed", job.Progress.Failed)
+
+	return nil
+}
+
+// applyJob grants or revokes the job's badge, returning false if the player already had (or didn't have) it
+func (s *serviceImpl) applyJob(ctx context.Context, job model.BadgeJob, playerID uuid.UUID) (bool, error) {
+	var err error
+	switch job.Action {
+	case model.BadgeJobActionGrant:
+		if job.ExpiresAt != nil {
+			err = s.badgeSvc.AddTemporaryBadgeToPlayer(ctx, playerID, job.BadgeID, *job.ExpiresAt)
+		} else {
+			err = s.badgeSvc.AddBadgeToPlayer(ctx, playerID, job.BadgeID)
+		}
+		if errors.Is(err, badge.AlreadyHasBadgeErr) {
+			return false, nil
+		}
+	case model.BadgeJobActionRevoke:
+		err = s.badgeSvc.RemoveBadgeFromPlayer(ctx, playerID, job.BadgeID)
+		if errors.Is(err, badge.DoesntHaveBadgeErr) {
+			return false, nil
+		}
+	default:
+		return false, fmt.Errorf("unknown badge job action %s", job.Action)
+	}
+
+	return err == nil, err
+}
+
+// updateActiveBadges recalculates the active badge of each player once the batch has been granted,
+// as granting a badge only changes the active badge if it's required or forced
+func (s *serviceImpl) updateActiveBadges(ctx context.Context, playerIDs []uuid.UUID) {
+	for _, playerID := range playerIDs {
+		if err := s.badgeSvc.UpdateActiveBadge(ctx, playerID); err != nil {
+			s.log.Errorw("failed to update active badge after badge job", "playerId", playerID, "error", err)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package badgejob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/emortalmc/proto-specs/gen/go/model/common"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+	"mc-player-service/internal/app/badge"
+	"mc-player-service/internal/config"
+	"mc-player-service/internal/repository"
+	"mc-player-service/internal/repository/model"
+	"mc-player-service/internal/utils/experience"
+	"time"
+)
+
+const (
+	jobBatchSize = 200
+	// jobLease how long a replica may run a job for without saving its progress before another replica takes over
+	jobLease = 5 * time.Minute
+	// maxJobPlayerIDs keeps jobs for a list of players well under Mongo's document size limit
+	maxJobPlayerIDs = 100_000
+)
+
+// Service runs jobs that grant or revoke a badge for many players in the background.
+// Jobs are stored in the database so that they survive restarts and are shared between replicas.
+type Service interface {
+	// CreateJob queues a job, recording its changes against the audit in ctx
+	CreateJob(ctx context.Context, req JobRequest) (model.BadgeJob, error)
+	GetJob(ctx context.Context, id primitive.ObjectID) (model.BadgeJob, error)
+	// GetJobs returns jobs newest first, without their player IDs
+	GetJobs(ctx context.Context, pageable *common.Pageable) ([]model.BadgeJob, *common.PageData, error)
+	// CancelJob stops the job after its current batch. ErrJobFinished is returned if the job has already finished.
+	CancelJob(ctx context.Context, id primitive.ObjectID) error
+
+	// RunJobs runs queued jobs, and jobs a stopped replica was running, until there are none left.
+	// Jobs are resumed from the last batch that was saved.
+	RunJobs(ctx context.Context) error
+}
+
+type serviceImpl struct {
+	log *zap.SugaredLogger
+
+	repo     repository.BadgeJobReadWriter
+	badgeSvc badge.Service
+	badgeCfg *config.BadgeConfigHolder
+}
+
+func NewService(log *zap.SugaredLogger, repo repository.BadgeJobReadWriter, badgeSvc badge.Service,
+	badgeCfg *config.BadgeConfigHolder) Service {
+
+	return &serviceImpl{
+		log:      log,
+		repo:     repo,
+		badgeSvc: badgeSvc,
+		badgeCfg: badgeCfg,
+	}
+}
+
+var (
+	ErrInvalidJob  = errors.New("invalid badge job")
+	ErrJobNotFound = errors.New("badge job not found")
+	ErrJobFinished = errors.New("badge job has already finished")
+)
+
+// JobRequest either PlayerIDs or PlayerFilter must be set
+type JobRequest struct {
+	// Action either model.BadgeJobActionGrant or model.BadgeJobActionRevoke
+	Action  string
+	BadgeID string
+	// ExpiresAt if not nil, granted badges are temporary
+	ExpiresAt *time.Time
+
+	PlayerIDs    []uuid.UUID
+	PlayerFilter *model.BadgeJobFilter
+}
+
+func (s *serviceImpl) CreateJob(ctx context.Context, req JobRequest) (model.BadgeJob, error) {
+	if err := s.validateRequest(req); err != nil {
+		return model.BadgeJob{}, err
+	}
+
+	now := time.Now()
+	audit := badge.AuditFromContext(ctx)
+	job := model.BadgeJob{
+		ID:           primitive.NewObjectIDFromTimestamp(now),
+		Action:       req.Action,
+		BadgeID:      req.BadgeID,
+		ExpiresAt:    req.ExpiresAt,
+		PlayerFilter: req.PlayerFilter,
+		Status:       model.BadgeJobStatusPending,
+		Actor:        audit.Actor,
+		Reason:       audit.Reason,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if req.PlayerFilter == nil {
+		job.PlayerIDs = uniquePlayerIDs(req.PlayerIDs)
+	}
+
+	total, err := s.repo.CountFilteredPlayers(ctx, playerFilter(job))
+	if err != nil {
+		return model.BadgeJob{}, fmt.Errorf("failed to count players: %w", err)
+	}
+	job.Total = total
+
+	if err := s.repo.CreateBadgeJob(ctx, job); err != nil {
+		return model.BadgeJob{}, fmt.Errorf("failed to create badge job: %w", err)
+	}
+
+	s.log.Infow("created badge job", "jobId", job.ID, "action", job.Action, "badgeId", job.BadgeID,
+		"total", job.Total, "actor", job.Actor)
+
+	return job, nil
+}
+
+func (s *serviceImpl) validateRequest(req JobRequest) error {
+	switch req.Action {
+	case model.BadgeJobActionGrant:
+		if _, ok := s.badgeCfg.Get().Badges[req.BadgeID]; !ok {
+			return fmt.Errorf("%w: badge %q does not exist", ErrInvalidJob, req.BadgeID)
+		}
+		if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+			return fmt.Errorf("%w: %w", ErrInvalidJob, badge.ErrExpiryInPast)
+		}
+	case model.BadgeJobActionRevoke:
+		// Badges that have been removed from the config may still be revoked
+		if req.BadgeID == "" {
+			return fmt.Errorf("%w: badge id is required", ErrInvalidJob)
+		}
+		if req.ExpiresAt != nil {
+			return fmt.Errorf("%w: expiry can only be set when granting", ErrInvalidJob)
+		}
+	default:
+		return fmt.Errorf("%w: unknown action %q", ErrInvalidJob, req.Action)
+	}
+
+	if (len(req.PlayerIDs) > 0) == (req.PlayerFilter != nil) {
+		return fmt.Errorf("%w: exactly one of a player list and a player filter is required", ErrInvalidJob)
+	}
+	if len(req.PlayerIDs) > maxJobPlayerIDs {
+		return fmt.Errorf("%w: at most %d players may be listed", ErrInvalidJob, maxJobPlayerIDs)
+	}
+
+	if filter := req.PlayerFilter; filter != nil {
+		if filter.FirstLoginAfter == nil && filter.FirstLoginBefore == nil && filter.MinLevel == nil && filter.MinPlaytime == nil {
+			// Stops a job for every player being created by mistake
+			return fmt.Errorf("%w: player filter has no conditions", ErrInvalidJob)
+		}
+		if filter.FirstLoginAfter != nil && filter.FirstLoginBefore != nil && !filter.FirstLoginAfter.Before(*filter.FirstLoginBefore) {
+			return fmt.Errorf("%w: first login after must be before first login before", ErrInvalidJob)
+		}
+		if filter.MinLevel != nil && *filter.MinLevel <= 0 {
+			return fmt.Errorf("%w: min level must be positive", ErrInvalidJob)
+		}
+		if filter.MinPlaytime != nil && *filter.MinPlaytime <= 0 {
+			return fmt.Errorf("%w: min playtime must be positive", ErrInvalidJob)
+		}
+	}
+
+	return nil
+}
+
+func uniquePlayerIDs(playerIDs []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]struct{}, len(playerIDs))
+	unique := make([]uuid.UUID, 0, len(playerIDs))
+	for _, playerID := range playerIDs {
+		if _, ok := seen[playerID]; ok {
+			continue
+		}
+		seen[playerID] = struct{}{}
+		unique = append(unique, playerID)
+	}
+
+	return unique
+}
+
+// playerFilter returns the filter matching the players the job is for
+func playerFilter(job model.BadgeJob) repository.PlayerFilter {
+	if job.PlayerFilter == nil {
+		return repository.PlayerFilter{IDs: job.PlayerIDs}
+	}
+
+	filter := repository.PlayerFilter{
+		FirstLoginAfter:  job.PlayerFilter.FirstLoginAfter,
+		FirstLoginBefore: job.PlayerFilter.FirstLoginBefore,
+		MinPlaytime:      job.PlayerFilter.MinPlaytime,
+	}
+	if job.PlayerFilter.MinLevel != nil {
+		minExperience := int64(experience.LevelToXP(*job.PlayerFilter.MinLevel))
+		filter.MinExperience = &minExperience
+	}
+
+	return filter
+}
+
+func (s *serviceImpl) GetJob(ctx context.Context, id primitive.ObjectID) (model.BadgeJob, error) {
+	job, err := s.repo.GetBadgeJob(ctx, id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return model.BadgeJob{}, ErrJobNotFound
+		}
+		return model.BadgeJob{}, fmt.Errorf("failed to get badge job: %w", err)
+	}
+
+	return job, nil
+}
+
+func (s *serviceImpl) GetJobs(ctx context.Context, pageable *common.Pageable) ([]model.BadgeJob, *common.PageData, error) {
+	return s.repo.GetBadgeJobs(ctx, pageable)
+}
+
+func (s *serviceImpl) CancelJob(ctx context.Context, id primitive.ObjectID) error {
+	if _, err := s.GetJob(ctx, id); err != nil {
+		return err
+	}
+
+	cancelled, err := s.repo.CancelBadgeJob(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to cancel badge job: %w", err)
+	}
+	if !cancelled {
+		return ErrJobFinished
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"go.uber.org/zap"
+	"mc-player-service/internal/app/catalogue"
+	"mc-player-service/internal/config"
+	kafkaWriter "mc-player-service/internal/kafka/writer"
+	"mc-player-service/internal/repository"
+	"sync"
+)
+
+// ImportBadges copies the badges in the badge config file at path into the badge catalogue.
+// Badges already in the catalogue are skipped unless overwrite is true.
+func ImportBadges(ctx context.Context, cfg config.Config, log *zap.SugaredLogger, path string, overwrite bool) error {
+	badgeCfg, err := config.LoadBadgeConfigFrom(path)
+	if err != nil {
+		return fmt.Errorf("failed to load badge config: %w", err)
+	}
+	if err := badgeCfg.Validate(nil); err != nil {
+		return err
+	}
+
+	repoWg := &sync.WaitGroup{}
+	repoCtx, repoCancel := context.WithCancel(ctx)
+	defer func() {
+		repoCancel()
+		repoWg.Wait()
+	}()
+
+	repo, err := repository.NewMongoRepository(repoCtx, log, repoWg, cfg.MongoDB)
+	if err != nil {
+		return err
+	}
+
+	// The notifier is closed before the repository so that the change notification is flushed
+	notifierWg := &sync.WaitGroup{}
+	notifierCtx, notifierCancel := context.WithCancel(ctx)
+	defer func() {
+		notifierCancel()
+		notifierWg.Wait()
+	}()
+	notifier := kafkaWriter.NewKafkaNotifier(notifierCtx, notifierWg, cfg.Kafka, log)
+
+	catalogueSvc := catalogue.NewService(log, repo, notifier, config.NewBadgeConfigHolder(badgeCfg))
+
+	summary, err := catalogueSvc.Import(ctx, badgeCfg, overwrite)
+	if err != nil {
+		return err
+	}
+
+	log.Infow("badge import complete", "imported", summary.Imported, "skipped", summary.Skipped, "overwrite", overwrite)
+	return nil
+}
@@ -0,0 +1,14 @@
+package catalogue
+
+import (
+	"context"
+	kafkaWriter "mc-player-service/internal/kafka/writer"
+)
+
+var (
+	_ KafkaWriter = &kafkaWriter.Notifier{}
+)
+
+type KafkaWriter interface {
+	BadgeCatalogueChanged(ctx context.Context, badgeIDs []string)
+}
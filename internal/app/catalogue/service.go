@@ -0,0 +1,361 @@
+package catalogue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+	"mc-player-service/internal/config"
+	"mc-player-service/internal/repository"
+	"mc-player-service/internal/repository/model"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// maxChangeAttempts how many times a change is validated before giving up on other changes being made concurrently
+	maxChangeAttempts = 5
+	// changeRetryDelay is multiplied by the attempt before validating a change again
+	changeRetryDelay = 100 * time.Millisecond
+	// changeWriteLease how long a change can take to write before other changes may be written
+	changeWriteLease = 30 * time.Second
+)
+
+// Service edits the badges stored in the database. Every change is validated against the rest of the badge config
+// and notified so that every replica reloads the badge config.
+type Service interface {
+	GetBadges(ctx context.Context, includeArchived bool) ([]model.CatalogueBadge, error)
+	CreateBadge(ctx context.Context, badge config.Badge) error
+	// UpdateBadge replaces the definition of the badge. Archived badges may be updated.
+	UpdateBadge(ctx context.Context, badge config.Badge) error
+	// SetBadgeArchived removes the badge from the badge config, or restores it
+	SetBadgeArchived(ctx context.Context, badgeID string, archived bool) error
+	// ReorderBadges reassigns the priorities the badges already use between them, highest priority first
+	ReorderBadges(ctx context.Context, badgeIDs []string) error
+
+	// Import copies every badge in the config into the database. Badges that already exist are only replaced if overwrite is true.
+	Import(ctx context.Context, cfg config.BadgeConfig, overwrite bool) (ImportSummary, error)
+
+	// Load returns fileCfg with its badges replaced by the badges in the database that aren't archived
+	Load(ctx context.Context, fileCfg config.BadgeConfig) (config.BadgeConfig, error)
+}
+
+type serviceImpl struct {
+	log *zap.SugaredLogger
+
+	repo     repository.BadgeCatalogueReadWriter
+	notif    KafkaWriter
+	badgeCfg *config.BadgeConfigHolder
+}
+
+// NewService creates a catalogue service. Changes are validated against the groups and rarity lore of badgeCfg.
+func NewService(log *zap.SugaredLogger, repo repository.BadgeCatalogueReadWriter, notif KafkaWriter,
+	badgeCfg *config.BadgeConfigHolder) Service {
+
+	return &serviceImpl{
+		log:      log,
+		repo:     repo,
+		notif:    notif,
+		badgeCfg: badgeCfg,
+	}
+}
+
+var (
+	ErrBadgeExists   = errors.New("badge already exists")
+	ErrBadgeNotFound = errors.New("badge not found")
+	ErrInvalidBadge  = errors.New("invalid badge")
+	// ErrConcurrentChange the catalogue kept changing while a change was being validated
+	ErrConcurrentChange = errors.New("badge catalogue is being changed concurrently")
+)
+
+type ImportSummary struct {
+	Imported int
+	// Skipped badges that already existed and weren't overwritten
+	Skipped int
+}
+
+func (s *serviceImpl) GetBadges(ctx context.Context, includeArchived bool) ([]model.CatalogueBadge, error) {
+	return s.repo.GetCatalogueBadges(ctx, includeArchived)
+}
+
+func (s *serviceImpl) CreateBadge(ctx context.Context, badge config.Badge) error {
+	if badge.Id != strings.ToLower(badge.Id) {
+		return fmt.Errorf("%w: id %q must be lowercase", ErrInvalidBadge, badge.Id)
+	}
+
+	validate := func(ctx context.Context) error {
+		return s.validateChange(ctx, func(badges map[string]*config.Badge) {
+			badges[badge.Id] = &badge
+		})
+	}
+
+	if err := s.writeChange(ctx, validate, func(ctx context.Context) error {
+		if err := s.repo.CreateCatalogueBadge(ctx, badge); err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return ErrBadgeExists
+			}
+			return fmt.Errorf("failed to create badge: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	s.notif.BadgeCatalogueChanged(ctx, []string{badge.Id})
+	return nil
+}
+
+func (s *serviceImpl) UpdateBadge(ctx context.Context, badge config.Badge) error {
+	validate := func(ctx context.Context) error {
+		archived, err := s.isArchived(ctx, badge.Id)
+		if err != nil {
+			return err
+		}
+
+		// Archived badges aren't part of the config so the update can't affect it
+		if archived {
+			return nil
+		}
+		return s.validateChange(ctx, func(badges map[string]*config.Badge) {
+			badges[badge.Id] = &badge
+		})
+	}
+
+	if err := s.writeChange(ctx, validate, func(ctx context.Context) error {
+		if err := s.repo.UpdateCatalogueBadge(ctx, badge); err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return ErrBadgeNotFound
+			}
+			return fmt.Errorf("failed to update badge: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	s.notif.BadgeCatalogueChanged(ctx, []string{badge.Id})
+	return nil
+}
+
+func (s *serviceImpl) SetBadgeArchived(ctx context.Context, badgeID string, archived bool) error {
+	validate := func(ctx context.Context) error {
+		badges, err := s.repo.GetCatalogueBadges(ctx, true)
+		if err != nil {
+			return fmt.Errorf("failed to get badges: %w", err)
+		}
+
+		i := slices.IndexFunc(badges, func(b model.CatalogueBadge) bool { return b.Id == badgeID })
+		if i == -1 {
+			return ErrBadgeNotFound
+		}
+		badge := badges[i]
+
+		return s.validateChange(ctx, func(badges map[string]*config.Badge) {
+			if archived {
+				delete(badges, badgeID)
+			} else {
+				badges[badgeID] = &badge.Badge
+			}
+		})
+	}
+
+	if err := s.writeChange(ctx, validate, func(ctx context.Context) error {
+		if err := s.repo.SetCatalogueBadgeArchived(ctx, badgeID, archived); err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return ErrBadgeNotFound
+			}
+			return fmt.Errorf("failed to set badge archived: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	s.notif.BadgeCatalogueChanged(ctx, []string{badgeID})
+	return nil
+}
+
+func (s *serviceImpl) ReorderBadges(ctx context.Context, badgeIDs []string) error {
+	// The priorities are worked out from the current catalogue, so are worked out again if it changes
+	var newPriorities map[string]int
+	validate := func(ctx context.Context) error {
+		badges, err := s.getBadgeMap(ctx)
+		if err != nil {
+			return err
+		}
+
+		priorities := make([]int, 0, len(badgeIDs))
+		for i, badgeID := range badgeIDs {
+			badge, ok := badges[badgeID]
+			if !ok {
+				return fmt.Errorf("%w: %s", ErrBadgeNotFound, badgeID)
+			}
+			if slices.Contains(badgeIDs[:i], badgeID) {
+				return fmt.Errorf("%w: %s is listed more than once", ErrInvalidBadge, badgeID)
+			}
+
+			priorities = append(priorities, badge.Priority)
+		}
+		sort.Sort(sort.Reverse(sort.IntSlice(priorities)))
+
+		newPriorities = make(map[string]int, len(badgeIDs))
+		for i, badgeID := range badgeIDs {
+			newPriorities[badgeID] = priorities[i]
+		}
+		return nil
+	}
+
+	if err := s.writeChange(ctx, validate, func(ctx context.Context) error {
+		if err := s.repo.SetCatalogueBadgePriorities(ctx, newPriorities); err != nil {
+			return fmt.Errorf("failed to set badge priorities: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	s.notif.BadgeCatalogueChanged(ctx, badgeIDs)
+	return nil
+}
+
+func (s *serviceImpl) Import(ctx context.Context, cfg config.BadgeConfig, overwrite bool) (ImportSummary, error) {
+	var summary ImportSummary
+
+	ids := make([]string, 0, len(cfg.Badges))
+	for id := range cfg.Badges {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	// The imported config is validated before importing, so it only has to claim a revision
+	// so that changes validated against the catalogue before the import are validated again
+	var imported []string
+	noValidation := func(context.Context) error { return nil }
+	err := s.writeChange(ctx, noValidation, func(ctx context.Context) error {
+		for _, id := range ids {
+			written, err := s.repo.UpsertCatalogueBadge(ctx, *cfg.Badges[id], overwrite)
+			if err != nil {
+				return fmt.Errorf("failed to import badge %s: %w", id, err)
+			}
+
+			if !written {
+				summary.Skipped++
+				continue
+			}
+			summary.Imported++
+			imported = append(imported, id)
+		}
+		return nil
+	})
+
+	if len(imported) > 0 {
+		s.notif.BadgeCatalogueChanged(ctx, imported)
+	}
+
+	return summary, err
+}
+
+func (s *serviceImpl) Load(ctx context.Context, fileCfg config.BadgeConfig) (config.BadgeConfig, error) {
+	badges, err := s.getBadgeMap(ctx)
+	if err != nil {
+		return config.BadgeConfig{}, err
+	}
+
+	fileCfg.Badges = badges
+	return fileCfg, nil
+}
+
+// writeChange runs validate then write, making sure the catalogue didn't change in between.
+// The catalogue's revision is read before validating and compared and incremented before writing,
+// so if another change was made in the meantime the change is validated again against the new catalogue.
+func (s *serviceImpl) writeChange(ctx context.Context, validate func(ctx context.Context) error,
+	write func(ctx context.Context) error) error {
+
+	for attempt := 0; attempt < maxChangeAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(time.Duration(attempt) * changeRetryDelay):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		revision, err := s.repo.GetBadgeCatalogueRevision(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get catalogue revision: %w", err)
+		}
+
+		if err := validate(ctx); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		claimed, err := s.repo.ClaimBadgeCatalogueRevision(ctx, revision, now, now.Add(changeWriteLease))
+		if err != nil {
+			return fmt.Errorf("failed to claim catalogue revision: %w", err)
+		}
+		if !claimed {
+			s.log.Debugw("badge catalogue changed while validating, validating again", "revision", revision, "attempt", attempt+1)
+			continue
+		}
+
+		err = write(ctx)
+		// Finished even if the write failed as nothing else can be written until it is or the lease expires
+		if finishErr := s.repo.FinishBadgeCatalogueChange(context.WithoutCancel(ctx), revision+1); finishErr != nil {
+			s.log.Errorw("failed to finish badge catalogue change", "revision", revision+1, "error", finishErr)
+		}
+		return err
+	}
+
+	return ErrConcurrentChange
+}
+
+// validateChange validates the badge config that would result from applying change to the badges in the database
+func (s *serviceImpl) validateChange(ctx context.Context, change func(badges map[string]*config.Badge)) error {
+	badges, err := s.getBadgeMap(ctx)
+	if err != nil {
+		return err
+	}
+	change(badges)
+
+	cfg := *s.badgeCfg.Get()
+	cfg.Badges = badges
+	if err := cfg.Validate(nil); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidBadge, err)
+	}
+
+	return nil
+}
+
+func (s *serviceImpl) isArchived(ctx context.Context, badgeID string) (bool, error) {
+	badges, err := s.repo.GetCatalogueBadges(ctx, true)
+	if err != nil {
+		return false, fmt.Errorf("failed to get badges: %w", err)
+	}
+
+	for _, badge := range badges {
+		if badge.Id == badgeID {
+			return badge.Archived, nil
+		}
+	}
+
+	return false, ErrBadgeNotFound
+}
+
+// getBadgeMap returns the badges in the database that aren't archived, keyed by ID
+func (s *serviceImpl) getBadgeMap(ctx context.Context) (map[string]*config.Badge, error) {
+	catalogueBadges, err := s.repo.GetCatalogueBadges(ctx, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get badges: %w", err)
+	}
+
+	badges := make(map[string]*config.Badge, len(catalogueBadges))
+	for i := range catalogueBadges {
+		badges[catalogueBadges[i].Id] = &catalogueBadges[i].Badge
+	}
+
+	return badges, nil
+}
@@ -0,0 +1,42 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"go.uber.org/zap"
+	"mc-player-service/internal/app/badge"
+	"mc-player-service/internal/app/contributor"
+	"mc-player-service/internal/config"
+	"mc-player-service/internal/github"
+	"mc-player-service/internal/repository"
+	"sync"
+	"time"
+)
+
+const defaultContributorSyncInterval = 6 * time.Hour
+
+func syncContributorBadges(ctx context.Context, wg *sync.WaitGroup, log *zap.SugaredLogger, cfg config.GitHubConfig,
+	repo repository.Repository, badgeSvc badge.Service, badgeCfg *config.BadgeConfigHolder) {
+
+	client := github.NewClient(nil, cfg.BaseURL, cfg.Token)
+	syncer := contributor.NewSyncer(log, repo, repo, badgeSvc, badgeCfg, client, cfg.Orgs)
+
+	interval := cfg.SyncInterval
+	if interval <= 0 {
+		interval = defaultContributorSyncInterval
+	}
+
+	runPeriodically(ctx, wg, interval, func(ctx context.Context) {
+		summary, err := syncer.Sync(ctx)
+		if errors.Is(err, contributor.ErrSyncRunning) {
+			log.Debugw("skipping contributor badge sync as another replica is running it")
+			return
+		}
+		if err != nil {
+			log.Errorw("failed to sync contributor badges", "error", err, "players", summary.Players)
+			return
+		}
+
+		log.Infow("synced contributor badges", "players", summary.Players, "granted", summary.Granted, "revoked", summary.Revoked)
+	})
+}
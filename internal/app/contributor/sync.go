@@ -0,0 +1,252 @@
+package contributor
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"mc-player-service/internal/app/badge"
+	"mc-player-service/internal/config"
+	"mc-player-service/internal/github"
+	"mc-player-service/internal/repository"
+	"mc-player-service/internal/repository/model"
+	"slices"
+	"sort"
+	"time"
+)
+
+const (
+	// searchRequestInterval keeps requests within GitHub's search rate limit of 30 requests a minute
+	searchRequestInterval = 2 * time.Second
+
+	syncLeaseName = "contributor-sync"
+	// syncLease how long a replica may go without checking a player before another replica can start syncing
+	syncLease = 5 * time.Minute
+)
+
+// ErrSyncRunning is returned by Sync if another replica is already syncing
+var ErrSyncRunning = errors.New("contributor badges are being synced by another replica")
+
+// PullRequestCounter is implemented by github.Client
+type PullRequestCounter interface {
+	CountMergedPullRequests(ctx context.Context, username string, org string) (int, error)
+}
+
+// Syncer grants players with a linked GitHub account the contributor badge for the highest
+// AutomaticGrants.GitHubPullRequests threshold they meet, removing their other contributor badges.
+// Players without a linked account are never changed, so manually granted badges are kept.
+type Syncer struct {
+	log *zap.SugaredLogger
+
+	repo   repository.PlayerReader
+	leases repository.LeaseWriter
+	// leaseHolder identifies this replica's sync lease
+	leaseHolder string
+
+	badgeSvc badge.Service
+	badgeCfg *config.BadgeConfigHolder
+	counter  PullRequestCounter
+	orgs     []string
+
+	requestInterval time.Duration
+	// resumeAfter the last player checked before the previous sync was rate limited, so that the next sync
+	// starts after them rather than checking the same players again. uuid.Nil once a sync completes.
+	resumeAfter uuid.UUID
+}
+
+func NewSyncer(log *zap.SugaredLogger, repo repository.PlayerReader, leases repository.LeaseWriter, badgeSvc badge.Service,
+	badgeCfg *config.BadgeConfigHolder, counter PullRequestCounter, orgs []string) *Syncer {
+
+	return &Syncer{
+		log: log,
+
+		repo:        repo,
+		leases:      leases,
+		leaseHolder: uuid.NewString(),
+
+		badgeSvc: badgeSvc,
+		badgeCfg: badgeCfg,
+		counter:  counter,
+		orgs:     orgs,
+
+		requestInterval: searchRequestInterval,
+	}
+}
+
+type tier struct {
+	badgeID      string
+	pullRequests int
+}
+
+type SyncSummary struct {
+	Players int
+	Granted int
+	Revoked int
+}
+
+// Sync updates the contributor badges of every linked player. It stops early if GitHub rate limits it,
+// leaving the remaining players for the next run, which starts with them. Sync must not be called concurrently.
+// Only one replica syncs at a time as they share GitHub's rate limit, so ErrSyncRunning is returned
+// if another replica is syncing.
+func (s *Syncer) Sync(ctx context.Context) (SyncSummary, error) {
+	var summary SyncSummary
+
+	tiers := contributorTiers(s.badgeCfg.Get())
+	if len(tiers) == 0 {
+		return summary, nil
+	}
+
+	if err := s.extendLease(ctx); err != nil {
+		return summary, err
+	}
+	defer func() {
+		if err := s.leases.ReleaseLease(context.WithoutCancel(ctx), syncLeaseName, s.leaseHolder); err != nil {
+			s.log.Errorw("failed to release contributor sync lease", "error", err)
+		}
+	}()
+
+	players, err := s.repo.GetGitHubLinkedPlayers(ctx)
+	if err != nil {
+		return summary, fmt.Errorf("failed to get linked players: %w", err)
+	}
+
+	for _, player := range resumeOrder(players, s.resumeAfter) {
+		// Stops if the lease expired while checking the previous player and another replica took over
+		if err := s.extendLease(ctx); err != nil {
+			return summary, err
+		}
+
+		count, err := s.countPullRequests(ctx, player.GitHubUsername)
+		if err != nil {
+			if errors.Is(err, github.ErrRateLimited) || ctx.Err() != nil {
+				return summary, err
+			}
+
+			// Don't revoke anything if we can't tell how many pull requests they have
+			s.log.Warnw("failed to count pull requests", "playerId", player.ID, "gitHubUsername", player.GitHubUsername, "error", err)
+			continue
+		}
+
+		granted, revoked := s.applyTier(ctx, player, tiers, count)
+		summary.Players++
+		summary.Granted += granted
+		summary.Revoked += revoked
+		s.resumeAfter = player.ID
+	}
+
+	s.resumeAfter = uuid.Nil
+	return summary, nil
+}
+
+// extendLease takes or extends this replica's sync lease, returning ErrSyncRunning if another replica has it
+func (s *Syncer) extendLease(ctx context.Context) error {
+	now := time.Now()
+	acquired, err := s.leases.AcquireLease(ctx, syncLeaseName, s.leaseHolder, now, now.Add(syncLease))
+	if err != nil {
+		return fmt.Errorf("failed to acquire sync lease: %w", err)
+	}
+	if !acquired {
+		return ErrSyncRunning
+	}
+
+	return nil
+}
+
+// resumeOrder sorts the players by ID, starting with the first player after resumeAfter and wrapping around
+func resumeOrder(players []model.GitHubPlayer, resumeAfter uuid.UUID) []model.GitHubPlayer {
+	slices.SortFunc(players, func(a, b model.GitHubPlayer) int {
+		return bytes.Compare(a.ID[:], b.ID[:])
+	})
+
+	start, _ := slices.BinarySearchFunc(players, resumeAfter, func(p model.GitHubPlayer, id uuid.UUID) int {
+		return bytes.Compare(p.ID[:], id[:])
+	})
+	if start < len(players) && players[start].ID == resumeAfter {
+		start++
+	}
+
+	return append(players[start:len(players):len(players)], players[:start]...)
+}
+
+func (s *Syncer) countPullRequests(ctx context.Context, username string) (int, error) {
+	total := 0
+	for _, org := range s.orgs {
+		select {
+		case <-time.After(s.requestInterval):
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+
+		count, err := s.counter.CountMergedPullRequests(ctx, username, org)
+		if err != nil {
+			return 0, fmt.Errorf("failed to count pull requests in %s: %w", org, err)
+		}
+		total += count
+	}
+
+	return total, nil
+}
+
+// applyTier gives the player the badge of the highest tier they meet and removes all other tiers
+func (s *Syncer) applyTier(ctx context.Context, player model.GitHubPlayer, tiers []tier, count int) (granted int, revoked int) {
+	target := ""
+	for _, t := range tiers {
+		if count >= t.pullRequests {
+			target = t.badgeID
+			break
+		}
+	}
+
+	ctx = badge.WithAudit(ctx, badge.Audit{
+		Actor:  badge.ActorAutomatic,
+		Source: "github",
+		Reason: fmt.Sprintf("%s has %d merged pull requests", player.GitHubUsername, count),
+	})
+
+	for _, t := range tiers {
+		owned := slices.Contains(player.Badges, t.badgeID)
+
+		switch {
+		case t.badgeID == target && !owned:
+			err := s.badgeSvc.AddBadgeToPlayer(ctx, player.ID, t.badgeID)
+			if err != nil {
+				if !errors.Is(err, badge.AlreadyHasBadgeErr) {
+					s.log.Errorw("failed to grant contributor badge", "playerId", player.ID, "badgeId", t.badgeID, "error", err)
+				}
+				continue
+			}
+			granted++
+		case t.badgeID != target && owned:
+			err := s.badgeSvc.RemoveBadgeFromPlayer(ctx, player.ID, t.badgeID)
+			if err != nil {
+				if !errors.Is(err, badge.DoesntHaveBadgeErr) {
+					s.log.Errorw("failed to revoke contributor badge", "playerId", player.ID, "badgeId", t.badgeID, "error", err)
+				}
+				continue
+			}
+			revoked++
+		}
+	}
+
+	return granted, revoked
+}
+
+// contributorTiers returns every badge granted for pull requests, highest threshold first
+func contributorTiers(cfg *config.BadgeConfig) []tier {
+	var tiers []tier
+	for id, b := range cfg.Badges {
+		if b.AutomaticGrants == nil || b.AutomaticGrants.GitHubPullRequests == nil {
+			continue
+		}
+
+		tiers = append(tiers, tier{badgeID: id, pullRequests: *b.AutomaticGrants.GitHubPullRequests})
+	}
+
+	sort.Slice(tiers, func(i, j int) bool {
+		return tiers[i].pullRequests > tiers[j].pullRequests
+	})
+
+	return tiers
+}
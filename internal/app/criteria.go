@@ -0,0 +1,32 @@
+package app
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"mc-player-service/internal/app/badge"
+	"mc-player-service/internal/config"
+	"sync"
+	"time"
+)
+
+const defaultCriteriaSweepInterval = time.Hour
+
+func sweepBadgeCriteria(ctx context.Context, wg *sync.WaitGroup, log *zap.SugaredLogger, cfg config.BadgeCriteriaConfig,
+	badgeSvc badge.Service) {
+
+	interval := cfg.SweepInterval
+	if interval <= 0 {
+		interval = defaultCriteriaSweepInterval
+	}
+
+	runPeriodically(ctx, wg, interval, func(ctx context.Context) {
+		report, err := badgeSvc.SweepBadgeCriteria(ctx)
+		if err != nil {
+			log.Errorw("failed to sweep badge criteria", "error", err)
+			return
+		}
+
+		log.Infow("swept badge criteria", "playersChecked", report.PlayersChecked, "playersFailed", report.PlayersFailed,
+			"granted", report.Granted, "revoked", report.Revoked)
+	})
+}
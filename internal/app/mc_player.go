@@ -3,7 +3,10 @@ package app
 import (
 	"context"
 	"go.uber.org/zap"
+	"io"
 	"mc-player-service/internal/app/badge"
+	"mc-player-service/internal/app/badgejob"
+	"mc-player-service/internal/app/catalogue"
 	"mc-player-service/internal/app/player"
 	"mc-player-service/internal/config"
 	"mc-player-service/internal/grpc"
@@ -13,6 +16,7 @@ import (
 	"os/signal"
 	"sync"
 	"syscall"
+	"time"
 )
 
 func Run(cfg config.Config, log *zap.SugaredLogger) {
@@ -20,11 +24,9 @@ func Run(cfg config.Config, log *zap.SugaredLogger) {
 	defer cancel()
 	wg := &sync.WaitGroup{}
 
-	badgeCfg, err := config.LoadBadgeConfig()
-	if err != nil {
-		log.Fatalw("failed to load badge config", err)
+	if err := cfg.Experience.Validate(); err != nil {
+		log.Fatalw("invalid experience config", "error", err)
 	}
-	log.Infow("loaded badge config", "badgeCount", len(badgeCfg.Badges))
 
 	repoWg := &sync.WaitGroup{}
 	repoCtx, repoCancel := context.WithCancel(ctx)
@@ -34,14 +36,89 @@ func Run(cfg config.Config, log *zap.SugaredLogger) {
 		log.Fatalw("failed to create repository", err)
 	}
 
+	createSeasonIndexes(ctx, log, cfg.Experience, repo)
+
 	notifier := kafkaWriter.NewKafkaNotifier(ctx, wg, cfg.Kafka, log)
 
-	badgeSvc := badge.NewService(log, repo, repo, badgeCfg)
-	playerSvc := player.NewService(log, cfg, repo, notifier)
+	switch cfg.BadgeCatalogue.Source {
+	case "", config.BadgeSourceFile, config.BadgeSourceMongo:
+	default:
+		log.Fatalw("unknown badge catalogue source", "source", cfg.BadgeCatalogue.Source)
+	}
+
+	// The holder is created before the badges are loaded as the catalogue validates changes against it
+	badgeCfgHolder := config.NewBadgeConfigHolder(config.BadgeConfig{})
+	var catalogueSvc catalogue.Service
+	if cfg.BadgeCatalogue.Source == config.BadgeSourceMongo {
+		catalogueSvc = catalogue.NewService(log, repo, notifier, badgeCfgHolder)
+	}
+
+	badgeCfgLoader, err := newBadgeConfigLoader(log, catalogueSvc)
+	if err != nil {
+		log.Fatalw("failed to load badge config", err)
+	}
+	badgeCfg, err := badgeCfgLoader.loadInitial(ctx)
+	if err != nil {
+		log.Fatalw("invalid badge config", "error", err)
+	}
+	log.Infow("loaded badge config", "badgeCount", len(badgeCfg.Badges), "source", cfg.BadgeCatalogue.Source)
+	badgeCfgHolder.Swap(badgeCfg)
+
+	var roles badge.RoleSource
+	if cfg.PermissionService.Address != "" {
+		var conn io.Closer
+		roles, conn, err = newRoleSource(cfg.PermissionService)
+		if err != nil {
+			log.Fatalw("failed to connect to permission service", "error", err)
+		}
+		defer conn.Close()
+
+		validateBadgeRoles(ctx, log, roles, badgeCfg)
+	}
+
+	badgeSvc := badge.NewService(log, repo, repo, badgeCfgHolder, notifier, roles)
+	playerSvc := player.NewService(log, cfg, repo, notifier, player.NewBadgeRewardHook(badgeSvc))
 
 	kafkaConsumer.NewConsumer(ctx, wg, cfg, log, repo, badgeSvc, playerSvc)
 
-	grpc.RunServices(ctx, log, wg, cfg, badgeSvc, badgeCfg, playerSvc, repo)
+	runPeriodically(ctx, wg, time.Minute, func(ctx context.Context) {
+		if err := playerSvc.ArchiveEndedSeasons(ctx); err != nil {
+			log.Errorw("failed to archive ended seasons", "error", err)
+		}
+	})
+
+	runPeriodically(ctx, wg, time.Minute, func(ctx context.Context) {
+		if err := playerSvc.RetryFailedExperienceGrants(ctx); err != nil {
+			log.Errorw("failed to retry experience grants", "error", err)
+		}
+	})
+
+	runPeriodically(ctx, wg, time.Minute, func(ctx context.Context) {
+		removed, err := badgeSvc.ExpireBadges(ctx)
+		if err != nil {
+			log.Errorw("failed to expire badges", "error", err)
+			return
+		}
+		if removed > 0 {
+			log.Infow("removed expired badges", "count", removed)
+		}
+	})
+
+	badgeCfgLoader.watch(ctx, wg, cfg, badgeSvc)
+	sweepBadgeCriteria(ctx, wg, log, cfg.BadgeCriteria, badgeSvc)
+
+	if roles != nil && cfg.PermissionService.RoleResyncInterval > 0 {
+		resyncRoleBadgesPeriodically(ctx, wg, log, cfg.PermissionService.RoleResyncInterval, badgeSvc)
+	}
+
+	if len(cfg.GitHub.Orgs) > 0 {
+		syncContributorBadges(ctx, wg, log, cfg.GitHub, repo, badgeSvc, badgeCfgHolder)
+	}
+
+	jobSvc := badgejob.NewService(log, repo, badgeSvc, badgeCfgHolder)
+	runBadgeJobs(ctx, wg, log, jobSvc)
+
+	grpc.RunServices(ctx, log, wg, cfg, badgeSvc, badgeCfgHolder, catalogueSvc, jobSvc, playerSvc, repo)
 
 	wg.Wait()
 	log.Info("shutting down")
@@ -50,3 +127,18 @@ func Run(cfg config.Config, log *zap.SugaredLogger) {
 	repoCancel()
 	repoWg.Wait()
 }
+
+// createSeasonIndexes creates the leaderboard index of every season that may still be on the player documents
+func createSeasonIndexes(ctx context.Context, log *zap.SugaredLogger, cfg config.ExperienceConfig, repo repository.Repository) {
+	now := time.Now()
+	var seasonIDs []string
+	for _, season := range cfg.Seasons {
+		if !season.HasEnded(now) {
+			seasonIDs = append(seasonIDs, season.ID)
+		}
+	}
+
+	if err := repo.CreateSeasonLeaderboardIndexes(ctx, seasonIDs); err != nil {
+		log.Errorw("failed to create season leaderboard indexes", "error", err)
+	}
+}
@@ -0,0 +1,100 @@
+package player
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"math"
+	"mc-player-service/internal/repository/model"
+	"time"
+)
+
+var (
+	ErrInvalidMultiplier = errors.New("multiplier must be greater than 0")
+	ErrBoosterExpired    = errors.New("booster expiry must be in the future")
+	ErrBoosterNotFound   = errors.New("booster does not exist")
+)
+
+func (s *serviceImpl) AddExperienceBooster(ctx context.Context, playerID uuid.UUID, multiplier float64, source string,
+	expiresAt time.Time) (model.ExperienceBooster, error) {
+
+	if multiplier <= 0 {
+		return model.ExperienceBooster{}, ErrInvalidMultiplier
+	}
+	if !expiresAt.After(time.Now()) {
+		return model.ExperienceBooster{}, ErrBoosterExpired
+	}
+
+	booster := model.ExperienceBooster{
+		ID:         primitive.NewObjectID(),
+		PlayerID:   playerID,
+		Multiplier: multiplier,
+		Source:     source,
+		ExpiresAt:  expiresAt,
+	}
+
+	if err := s.repo.CreateExperienceBooster(ctx, booster); err != nil {
+		return model.ExperienceBooster{}, fmt.Errorf("failed to create booster: %w", err)
+	}
+
+	return booster, nil
+}
+
+func (s *serviceImpl) GetExperienceBoosters(ctx context.Context, playerID uuid.UUID) ([]model.ExperienceBooster, error) {
+	boosters, err := s.repo.GetActiveExperienceBoosters(ctx, playerID, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get boosters: %w", err)
+	}
+
+	return boosters, nil
+}
+
+func (s *serviceImpl) RemoveExperienceBooster(ctx context.Context, boosterID primitive.ObjectID) error {
+	if err := s.repo.DeleteExperienceBooster(ctx, boosterID); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return ErrBoosterNotFound
+		}
+		return fmt.Errorf("failed to delete booster: %w", err)
+	}
+
+	return nil
+}
+
+// getMultipliers returns every multiplier that applies to a grant made for the player at the given time.
+// Global events are listed before the player's own boosters.
+func (s *serviceImpl) getMultipliers(ctx context.Context, playerID uuid.UUID, at time.Time) ([]model.AppliedMultiplier, error) {
+	var multipliers []model.AppliedMultiplier
+	for _, event := range s.expCfg.ActiveEvents(at) {
+		multipliers = append(multipliers, model.AppliedMultiplier{
+			Source:     "event:" + event.Name,
+			Multiplier: event.Multiplier,
+		})
+	}
+
+	boosters, err := s.repo.GetActiveExperienceBoosters(ctx, playerID, at)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get boosters: %w", err)
+	}
+
+	for _, booster := range boosters {
+		multipliers = append(multipliers, model.AppliedMultiplier{
+			Source:     "booster:" + booster.ID.Hex(),
+			Multiplier: booster.Multiplier,
+		})
+	}
+
+	return multipliers, nil
+}
+
+// applyMultipliers stacks the multipliers multiplicatively, so a 2x event and a 1.5x booster give 3x
+func applyMultipliers(amount int, multipliers []model.AppliedMultiplier) int {
+	result := float64(amount)
+	for _, m := range multipliers {
+		result *= m.Multiplier
+	}
+
+	return int(math.Round(result))
+}
@@ -0,0 +1,27 @@
+package player
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"time"
+)
+
+// getRemainingCap returns how much more experience the player can earn for the reason at the given time,
+// or -1 if the reason is not capped
+func (s *serviceImpl) getRemainingCap(ctx context.Context, playerID uuid.UUID, reason string, at time.Time) (int, error) {
+	remaining := -1
+	for _, expCap := range s.expCfg.CapsForReason(reason) {
+		gained, err := s.repo.GetExperienceGainedSince(ctx, playerID, reason, at.Add(-expCap.Window))
+		if err != nil {
+			return 0, fmt.Errorf("failed to get experience gained: %w", err)
+		}
+
+		capRemaining := max(expCap.Amount-int(gained), 0)
+		if remaining == -1 || capRemaining < remaining {
+			remaining = capRemaining
+		}
+	}
+
+	return remaining, nil
+}
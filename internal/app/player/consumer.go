@@ -42,12 +42,16 @@ func (s *serviceImpl) HandlePlayerConnect(ctx context.Context, time time.Time, p
 		}
 		player.CurrentSkin = playerSkin
 		player.CurrentServer = server
+		player.LastOnline = time
 	}
+	player.UpdateLoginStreak(time)
 
-	if err := s.repo.SavePlayer(ctx, player, true); err != nil {
+	if err := s.repo.SavePlayerLogin(ctx, player); err != nil {
 		s.log.Errorw("error saving player", "error", err)
 		return
 	}
+	s.retryLevelRewards(ctx, playerID, player.Experience)
+	s.grantProgressRewards(ctx, playerID)
 	count, err := s.repo.GetPlayerCount(ctx, nil, nil)
 	if err != nil {
 		s.log.Errorw("error getting player count", "error", err)
@@ -86,6 +90,7 @@ func (s *serviceImpl) HandlePlayerDisconnect(ctx context.Context, time time.Time
 		s.log.Errorw("error logging out player", "error", err)
 		return
 	}
+	s.grantProgressRewards(ctx, playerID)
 
 	count, err := s.repo.GetPlayerCount(ctx, nil, nil)
 	if err != nil {
@@ -0,0 +1,62 @@
+package player
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"mc-player-service/internal/config"
+)
+
+type GameModeProgress struct {
+	GameMode   config.GameMode
+	Experience int64
+	Level      int
+}
+
+// getGameModeID returns the ID of the game mode a grant counts towards, or an empty string if there is none.
+// The reason takes priority over the fleet the player is currently on.
+func (s *serviceImpl) getGameModeID(ctx context.Context, playerID uuid.UUID, reason string) (string, error) {
+	if mode, ok := s.expCfg.GameModeForReason(reason); ok {
+		return mode.ID, nil
+	}
+
+	if !s.expCfg.HasFleetGameModes() {
+		return "", nil
+	}
+
+	servers, err := s.repo.GetPlayerServers(ctx, []uuid.UUID{playerID})
+	if err != nil {
+		return "", fmt.Errorf("failed to get player server: %w", err)
+	}
+
+	server, ok := servers[playerID]
+	if !ok {
+		return "", nil
+	}
+
+	if mode, ok := s.expCfg.GameModeForFleet(server.FleetName); ok {
+		return mode.ID, nil
+	}
+
+	return "", nil
+}
+
+// GetPlayerGameModes returns the player's progress in every configured game mode
+func (s *serviceImpl) GetPlayerGameModes(ctx context.Context, playerID uuid.UUID) ([]GameModeProgress, error) {
+	p, err := s.repo.GetPlayer(ctx, playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player: %w", err)
+	}
+
+	progress := make([]GameModeProgress, len(s.expCfg.GameModes))
+	for i, mode := range s.expCfg.GameModes {
+		xp := p.GameModeExperience[mode.ID]
+		progress[i] = GameModeProgress{
+			GameMode:   mode,
+			Experience: xp,
+			Level:      mode.GetCurve().XPToLevel(int(xp)),
+		}
+	}
+
+	return progress, nil
+}
@@ -0,0 +1,96 @@
+package player
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"mc-player-service/internal/repository/model"
+	"time"
+)
+
+const (
+	grantRetryBatchSize = 100
+	// maxGrantRetryDelay caps the exponential backoff between attempts at a failed grant
+	maxGrantRetryDelay = time.Hour
+	// maxGrantAttempts is how many times a grant is attempted before it's dropped, roughly a day of retries
+	maxGrantAttempts = 30
+)
+
+// IsPermanentGrantError returns true if retrying a grant that failed with the error can never succeed,
+// e.g. because the amount is invalid or the player doesn't exist
+func IsPermanentGrantError(err error) bool {
+	return errors.Is(err, ErrNegativeExperience) || errors.Is(err, mongo.ErrNoDocuments)
+}
+
+func (s *serviceImpl) QueueFailedExperienceGrant(ctx context.Context, playerID uuid.UUID, reason string, amount int,
+	idempotencyKey string, grantErr error) error {
+
+	now := time.Now()
+	return s.repo.CreateFailedExperienceGrant(ctx, model.FailedExperienceGrant{
+		ID:             primitive.NewObjectIDFromTimestamp(now),
+		PlayerID:       playerID,
+		Reason:         reason,
+		Experience:     int64(amount),
+		IdempotencyKey: idempotencyKey,
+		Attempts:       1,
+		LastError:      grantErr.Error(),
+		NextAttemptAt:  now.Add(grantRetryDelay(1)),
+	})
+}
+
+func (s *serviceImpl) RetryFailedExperienceGrants(ctx context.Context) error {
+	// Only one batch is retried at a time so that a grant that can't be rescheduled isn't retried in a loop
+	grants, err := s.repo.GetDueFailedExperienceGrants(ctx, time.Now(), grantRetryBatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to get failed experience grants: %w", err)
+	}
+
+	for _, grant := range grants {
+		s.retryExperienceGrant(ctx, grant)
+	}
+
+	return nil
+}
+
+func (s *serviceImpl) retryExperienceGrant(ctx context.Context, grant model.FailedExperienceGrant) {
+	_, err := s.AddIdempotentExperienceByID(ctx, grant.PlayerID, grant.Reason, int(grant.Experience), grant.IdempotencyKey)
+	if err != nil && !errors.Is(err, ErrDuplicateGrant) {
+		attempts := grant.Attempts + 1
+		if IsPermanentGrantError(err) || attempts >= maxGrantAttempts {
+			// The grant is logged in full so that it can be applied manually
+			s.log.Errorw("dropping experience grant", "playerId", grant.PlayerID, "reason", grant.Reason,
+				"experience", grant.Experience, "idempotencyKey", grant.IdempotencyKey, "attempts", attempts, "error", err)
+			s.deleteFailedExperienceGrant(ctx, grant.ID)
+			return
+		}
+
+		s.log.Errorw("failed to retry experience grant", "playerId", grant.PlayerID, "idempotencyKey", grant.IdempotencyKey,
+			"attempts", attempts, "error", err)
+
+		nextAttemptAt := time.Now().Add(grantRetryDelay(attempts))
+		if err := s.repo.RescheduleFailedExperienceGrant(ctx, grant.ID, nextAttemptAt, err.Error()); err != nil {
+			s.log.Errorw("failed to reschedule experience grant", "grantId", grant.ID, "error", err)
+		}
+		return
+	}
+
+	s.deleteFailedExperienceGrant(ctx, grant.ID)
+}
+
+func (s *serviceImpl) deleteFailedExperienceGrant(ctx context.Context, id primitive.ObjectID) {
+	if err := s.repo.DeleteFailedExperienceGrant(ctx, id); err != nil {
+		s.log.Errorw("failed to delete failed experience grant", "grantId", id, "error", err)
+	}
+}
+
+// grantRetryDelay doubles the delay with each attempt, starting at one minute
+func grantRetryDelay(attempts int) time.Duration {
+	if attempts > 6 {
+		return maxGrantRetryDelay
+	}
+
+	return min(time.Minute<<(attempts-1), maxGrantRetryDelay)
+}
@@ -12,4 +12,5 @@ var (
 
 type KafkaWriter interface {
 	PlayerExperienceChange(ctx context.Context, playerID uuid.UUID, reason string, oldXP int, newXP int, oldLevel int, newLevel int)
+	PlayerPrestige(ctx context.Context, playerID uuid.UUID, newPrestige int, oldXP int)
 }
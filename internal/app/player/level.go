@@ -0,0 +1,57 @@
+package player
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"mc-player-service/internal/config"
+	"mc-player-service/internal/utils/experience"
+	"sort"
+)
+
+type LevelProgress struct {
+	Experience  int64
+	Level       int
+	Prestige    int
+	CanPrestige bool
+	// Tier nil if the level is below every configured tier
+	Tier *config.LevelTier
+}
+
+func (s *serviceImpl) GetPlayerLevel(ctx context.Context, playerID uuid.UUID) (LevelProgress, error) {
+	current, err := s.repo.GetPlayerExperience(ctx, playerID)
+	if err != nil {
+		return LevelProgress{}, err
+	}
+
+	capXP := s.expCfg.LevelCapXP()
+	progress := LevelProgress{
+		Experience:  current.Experience,
+		Level:       experience.XPToLevel(int(current.Experience)),
+		Prestige:    current.Prestige,
+		CanPrestige: capXP != -1 && current.Experience >= int64(capXP),
+	}
+	progress.Tier = s.tierForLevel(progress.Level)
+
+	return progress, nil
+}
+
+// tierForLevel returns nil if the level is below every configured tier
+func (s *serviceImpl) tierForLevel(level int) *config.LevelTier {
+	tier, ok := s.expCfg.TierForLevel(level)
+	if !ok {
+		return nil
+	}
+
+	return &tier
+}
+
+func (s *serviceImpl) GetLevelTiers() []config.LevelTier {
+	tiers := make([]config.LevelTier, len(s.expCfg.Tiers))
+	copy(tiers, s.expCfg.Tiers)
+
+	sort.Slice(tiers, func(i, j int) bool {
+		return tiers[i].MinLevel < tiers[j].MinLevel
+	})
+
+	return tiers
+}
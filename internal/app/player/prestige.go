@@ -0,0 +1,69 @@
+package player
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"mc-player-service/internal/repository/model"
+	"mc-player-service/internal/utils/experience"
+)
+
+const prestigeReason = "prestige"
+
+var (
+	ErrPrestigeDisabled   = errors.New("prestiging is disabled as there is no level cap")
+	ErrLevelCapNotReached = errors.New("player has not reached the level cap")
+	ErrExperienceChanged  = errors.New("player's experience changed while prestiging")
+)
+
+func (s *serviceImpl) Prestige(ctx context.Context, playerID uuid.UUID) (int, error) {
+	capXP := s.expCfg.LevelCapXP()
+	if capXP == -1 {
+		return 0, ErrPrestigeDisabled
+	}
+
+	current, err := s.repo.GetPlayerExperience(ctx, playerID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get player experience: %w", err)
+	}
+
+	if current.Experience < int64(capXP) {
+		return 0, ErrLevelCapNotReached
+	}
+
+	newPrestige, err := s.repo.PrestigePlayer(ctx, playerID, current.Experience)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return 0, ErrExperienceChanged
+		}
+		return 0, fmt.Errorf("failed to prestige player: %w", err)
+	}
+
+	// Keeps the transaction ledger in line with the player's experience
+	if err := s.repo.CreateExperienceTransaction(ctx, model.ExperienceTransaction{
+		ID:         primitive.NewObjectID(),
+		PlayerID:   playerID,
+		BaseAmount: -current.Experience,
+		Amount:     -current.Experience,
+		Reason:     prestigeReason,
+	}); err != nil {
+		return 0, fmt.Errorf("failed to create experience transaction: %w", err)
+	}
+
+	oldXP := int(current.Experience)
+	s.kafkaW.PlayerExperienceChange(ctx, playerID, prestigeReason, oldXP, 0, experience.XPToLevel(oldXP), 0)
+	s.kafkaW.PlayerPrestige(ctx, playerID, newPrestige, oldXP)
+
+	if rewards := s.expCfg.RewardsForPrestige(newPrestige); len(rewards) > 0 {
+		for _, hook := range s.rewardHooks {
+			if err := hook.GrantPrestigeRewards(ctx, playerID, newPrestige, rewards); err != nil {
+				s.log.Errorw("failed to grant prestige rewards", "playerId", playerID, "prestige", newPrestige, "error", err)
+			}
+		}
+	}
+
+	return newPrestige, nil
+}
@@ -0,0 +1,53 @@
+package player
+
+import (
+	"context"
+	"fmt"
+	"go.uber.org/zap"
+	"mc-player-service/internal/repository"
+)
+
+type ReconciliationSummary struct {
+	Mismatched int
+	Repaired   int
+	// Skipped players whose experience changed while being repaired. Running again will pick them up.
+	Skipped int
+
+	// NetDifference the sum of (stored - ledger) across all mismatched players
+	NetDifference int64
+}
+
+// ReconcileExperience compares each player's stored experience with the sum of their experience transactions.
+// Mismatches are always logged; if repair is true the stored experience is overwritten with the ledger total.
+func ReconcileExperience(ctx context.Context, log *zap.SugaredLogger, repo repository.PlayerReadWriter, repair bool) (ReconciliationSummary, error) {
+	mismatches, err := repo.GetExperienceMismatches(ctx)
+	if err != nil {
+		return ReconciliationSummary{}, fmt.Errorf("failed to get experience mismatches: %w", err)
+	}
+
+	summary := ReconciliationSummary{Mismatched: len(mismatches)}
+	for _, mismatch := range mismatches {
+		difference := mismatch.Experience - mismatch.LedgerExperience
+		summary.NetDifference += difference
+
+		log.Infow("experience mismatch", "playerId", mismatch.PlayerID, "experience", mismatch.Experience,
+			"ledgerExperience", mismatch.LedgerExperience, "difference", difference)
+
+		if !repair {
+			continue
+		}
+
+		updated, err := repo.SetPlayerExperience(ctx, mismatch.PlayerID, mismatch.Experience, mismatch.LedgerExperience)
+		if err != nil {
+			return summary, fmt.Errorf("failed to repair player %s: %w", mismatch.PlayerID, err)
+		}
+
+		if updated {
+			summary.Repaired++
+		} else {
+			summary.Skipped++
+		}
+	}
+
+	return summary, nil
+}
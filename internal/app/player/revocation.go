@@ -0,0 +1,183 @@
+package player
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"mc-player-service/internal/repository/model"
+	"mc-player-service/internal/utils/experience"
+	"time"
+)
+
+var (
+	ErrNegativeExperience        = errors.New("experience amount must not be negative")
+	ErrTransactionNotFound       = errors.New("experience transaction does not exist")
+	ErrTransactionAlreadyRevoked = errors.New("experience transaction has already been revoked")
+	ErrTransactionNotRevocable   = errors.New("only transactions that granted experience can be revoked")
+)
+
+type RevocationResult struct {
+	TransactionsRevoked int
+	PlayersAffected     int
+	// ExperienceRevoked the experience actually removed. This may be less than the sum of
+	// the revoked transactions as a player's experience never drops below zero.
+	ExperienceRevoked int
+}
+
+func (s *serviceImpl) RevokeExperienceTransaction(ctx context.Context, transactionID primitive.ObjectID, reason string) (RevocationResult, error) {
+	transaction, err := s.repo.GetExperienceTransaction(ctx, transactionID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return RevocationResult{}, ErrTransactionNotFound
+		}
+		return RevocationResult{}, fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	if !transaction.IsRevocable() {
+		return RevocationResult{}, ErrTransactionNotRevocable
+	}
+	if transaction.RevokedBy != nil {
+		return RevocationResult{}, ErrTransactionAlreadyRevoked
+	}
+
+	result, err := s.revokePlayerTransactions(ctx, transaction.PlayerID, []model.ExperienceTransaction{transaction}, reason)
+	if err != nil {
+		return RevocationResult{}, err
+	}
+
+	if result.TransactionsRevoked == 0 {
+		// Another request revoked it between us reading and claiming it
+		return RevocationResult{}, ErrTransactionAlreadyRevoked
+	}
+
+	return result, nil
+}
+
+func (s *serviceImpl) RevokeExperienceByReason(ctx context.Context, transactionReason string, from time.Time, to time.Time,
+	reason string) (RevocationResult, error) {
+
+	transactions, err := s.repo.GetRevocableExperienceTransactions(ctx, transactionReason, from, to)
+	if err != nil {
+		return RevocationResult{}, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	byPlayer := make(map[uuid.UUID][]model.ExperienceTransaction)
+	for _, transaction := range transactions {
+		byPlayer[transaction.PlayerID] = append(byPlayer[transaction.PlayerID], transaction)
+	}
+
+	var total RevocationResult
+	for playerID, playerTransactions := range byPlayer {
+		result, err := s.revokePlayerTransactions(ctx, playerID, playerTransactions, reason)
+		if err != nil {
+			return total, fmt.Errorf("failed to revoke transactions for player %s: %w", playerID, err)
+		}
+
+		total.TransactionsRevoked += result.TransactionsRevoked
+		total.PlayersAffected += result.PlayersAffected
+		total.ExperienceRevoked += result.ExperienceRevoked
+	}
+
+	s.log.Infow("revoked experience by reason", "transactionReason", transactionReason, "from", from, "to", to,
+		"transactions", total.TransactionsRevoked, "players", total.PlayersAffected, "experience", total.ExperienceRevoked)
+
+	return total, nil
+}
+
+// revokePlayerTransactions revokes the transactions, which must all belong to the player, writing a compensating
+// transaction for each and emitting a single experience change for the player.
+func (s *serviceImpl) revokePlayerTransactions(ctx context.Context, playerID uuid.UUID, transactions []model.ExperienceTransaction,
+	reason string) (RevocationResult, error) {
+
+	// Claim each transaction first so that concurrent revocations can't both remove the experience
+	claimed := make([]model.ExperienceTransaction, 0, len(transactions))
+	compensatingIDs := make([]primitive.ObjectID, 0, len(transactions))
+	toRemove := 0
+	seasonToRemove := make(map[string]int)
+	gameModeToRemove := make(map[string]int)
+	for _, transaction := range transactions {
+		if !transaction.IsRevocable() {
+			continue
+		}
+		compensatingID := primitive.NewObjectID()
+
+		ok, err := s.repo.MarkExperienceTransactionRevoked(ctx, transaction.ID, compensatingID)
+		if err != nil {
+			s.releaseRevocationClaims(ctx, claimed, compensatingIDs)
+			return RevocationResult{}, fmt.Errorf("failed to mark transaction revoked: %w", err)
+		}
+		if !ok {
+			continue
+		}
+
+		claimed = append(claimed, transaction)
+		compensatingIDs = append(compensatingIDs, compensatingID)
+		toRemove += int(transaction.Amount)
+		if transaction.SeasonID != "" {
+			seasonToRemove[transaction.SeasonID] += int(transaction.Amount)
+		}
+		if transaction.GameModeID != "" {
+			gameModeToRemove[transaction.GameModeID] += int(transaction.Amount)
+		}
+	}
+
+	if len(claimed) == 0 {
+		return RevocationResult{}, nil
+	}
+
+	oldXP, newXP, err := s.repo.RemoveExperienceFromPlayer(ctx, playerID, toRemove, seasonToRemove, gameModeToRemove)
+	if err != nil {
+		s.releaseRevocationClaims(ctx, claimed, compensatingIDs)
+		return RevocationResult{}, fmt.Errorf("failed to remove experience from player: %w", err)
+	}
+
+	// The experience has been removed, so from here on the claims must be kept even if recording the
+	// compensating transactions fails, otherwise a retry would remove the experience twice
+
+	// The player may have had less experience than we tried to remove, so only record what was actually removed
+	remaining := oldXP - newXP
+	for i, transaction := range claimed {
+		removed := min(int(transaction.Amount), remaining)
+		remaining -= removed
+		revokedID := transaction.ID
+
+		if err := s.repo.CreateExperienceTransaction(ctx, model.ExperienceTransaction{
+			ID:               compensatingIDs[i],
+			PlayerID:         playerID,
+			BaseAmount:       -int64(removed),
+			Amount:           -int64(removed),
+			Reason:           transaction.Reason,
+			SeasonID:         transaction.SeasonID,
+			GameModeID:       transaction.GameModeID,
+			Revokes:          &revokedID,
+			RevocationReason: reason,
+		}); err != nil {
+			return RevocationResult{}, fmt.Errorf("failed to create compensating transaction: %w", err)
+		}
+	}
+
+	s.kafkaW.PlayerExperienceChange(ctx, playerID, reason, oldXP, newXP, experience.XPToLevel(oldXP), experience.XPToLevel(newXP))
+
+	return RevocationResult{
+		TransactionsRevoked: len(claimed),
+		PlayersAffected:     1,
+		ExperienceRevoked:   oldXP - newXP,
+	}, nil
+}
+
+// releaseRevocationClaims unmarks claimed transactions whose experience wasn't removed so that the revocation can be retried
+func (s *serviceImpl) releaseRevocationClaims(ctx context.Context, claimed []model.ExperienceTransaction,
+	compensatingIDs []primitive.ObjectID) {
+
+	// The removal may have failed because ctx was cancelled, but the claims must still be released
+	ctx = context.WithoutCancel(ctx)
+	for i, transaction := range claimed {
+		if err := s.repo.UnmarkExperienceTransactionRevoked(ctx, transaction.ID, compensatingIDs[i]); err != nil {
+			s.log.Errorw("failed to release revocation claim, the transaction must be unmarked manually",
+				"transactionId", transaction.ID, "error", err)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package player
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"mc-player-service/internal/app/badge"
+	"mc-player-service/internal/config"
+	"mc-player-service/internal/repository/model"
+	"mc-player-service/internal/utils/experience"
+)
+
+// RewardHook gives a player their rewards for progressing.
+// GrantLevelRewards is called once per player and level, unless granting the level's rewards fails,
+// in which case it's called again when the player next connects so it must be safe to repeat.
+type RewardHook interface {
+	GrantLevelRewards(ctx context.Context, playerID uuid.UUID, level int, rewards []config.LevelReward) error
+	GrantPrestigeRewards(ctx context.Context, playerID uuid.UUID, prestige int, rewards []config.PrestigeReward) error
+	// GrantProgressRewards is called whenever the player's level, playtime or login streak may have changed,
+	// so may be called many times without the player having earned anything new
+	GrantProgressRewards(ctx context.Context, playerID uuid.UUID) error
+}
+
+type badgeRewardHook struct {
+	badgeSvc badge.Service
+}
+
+// NewBadgeRewardHook creates a RewardHook that grants the badges of each reward
+func NewBadgeRewardHook(badgeSvc badge.Service) RewardHook {
+	return &badgeRewardHook{badgeSvc: badgeSvc}
+}
+
+func (h *badgeRewardHook) GrantLevelRewards(ctx context.Context, playerID uuid.UUID, level int, rewards []config.LevelReward) error {
+	ctx = badge.WithAudit(ctx, badge.Audit{Actor: badge.ActorAutomatic, Source: "level-reward", Reason: fmt.Sprintf("reached level %d", level)})
+
+	var badgeIDs []string
+	for _, reward := range rewards {
+		badgeIDs = append(badgeIDs, reward.Badges...)
+	}
+
+	return h.addBadges(ctx, playerID, badgeIDs)
+}
+
+func (h *badgeRewardHook) GrantPrestigeRewards(ctx context.Context, playerID uuid.UUID, prestige int, rewards []config.PrestigeReward) error {
+	ctx = badge.WithAudit(ctx, badge.Audit{Actor: badge.ActorAutomatic, Source: "prestige-reward", Reason: fmt.Sprintf("reached prestige %d", prestige)})
+
+	var badgeIDs []string
+	for _, reward := range rewards {
+		badgeIDs = append(badgeIDs, reward.Badges...)
+	}
+
+	return h.addBadges(ctx, playerID, badgeIDs)
+}
+
+func (h *badgeRewardHook) GrantProgressRewards(ctx context.Context, playerID uuid.UUID) error {
+	return h.badgeSvc.EvaluateBadgeCriteria(ctx, playerID)
+}
+
+func (h *badgeRewardHook) addBadges(ctx context.Context, playerID uuid.UUID, badgeIDs []string) error {
+	var errs []error
+	for _, badgeID := range badgeIDs {
+		err := h.badgeSvc.AddBadgeToPlayer(ctx, playerID, badgeID)
+		if err != nil && !errors.Is(err, badge.AlreadyHasBadgeErr) {
+			errs = append(errs, fmt.Errorf("failed to add badge %s: %w", badgeID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// grantLevelRewards gives the player the rewards for every level in (oldLevel, newLevel],
+// so a grant that skips several levels still gives every reward
+func (s *serviceImpl) grantLevelRewards(ctx context.Context, playerID uuid.UUID, oldLevel int, newLevel int) {
+	for level := oldLevel + 1; level <= newLevel; level++ {
+		s.grantLevelReward(ctx, playerID, level)
+	}
+}
+
+// retryLevelRewards gives the player the rewards for levels up to their current level that they haven't been given,
+// e.g. because granting them failed when they levelled up
+func (s *serviceImpl) retryLevelRewards(ctx context.Context, playerID uuid.UUID, xp int64) {
+	claimedLevels, err := s.repo.GetClaimedRewardLevels(ctx, playerID)
+	if err != nil {
+		s.log.Errorw("failed to get claimed reward levels", "playerId", playerID, "error", err)
+		return
+	}
+
+	claimed := make(map[int]struct{}, len(claimedLevels))
+	for _, level := range claimedLevels {
+		claimed[level] = struct{}{}
+	}
+
+	currentLevel := experience.XPToLevel(int(xp))
+	for level := 1; level <= currentLevel; level++ {
+		if _, ok := claimed[level]; ok {
+			continue
+		}
+		s.grantLevelReward(ctx, playerID, level)
+	}
+}
+
+func (s *serviceImpl) grantLevelReward(ctx context.Context, playerID uuid.UUID, level int) {
+	rewards := s.expCfg.RewardsForLevel(level)
+	if len(rewards) == 0 {
+		return
+	}
+
+	// The claim is made before granting so that two level-ups at once can't both give the rewards.
+	// It's removed again if granting fails so that the rewards are retried rather than lost.
+	claim := model.LevelRewardClaim{
+		ID:       primitive.NewObjectID(),
+		PlayerID: playerID,
+		Level:    level,
+	}
+	claimed, err := s.repo.CreateLevelRewardClaim(ctx, claim)
+	if err != nil {
+		s.log.Errorw("failed to claim level rewards", "playerId", playerID, "level", level, "error", err)
+		return
+	}
+	if !claimed {
+		return
+	}
+
+	failed := false
+	for _, hook := range s.rewardHooks {
+		if err := hook.GrantLevelRewards(ctx, playerID, level, rewards); err != nil {
+			s.log.Errorw("failed to grant level rewards", "playerId", playerID, "level", level, "error", err)
+			failed = true
+		}
+	}
+	if !failed {
+		return
+	}
+
+	if err := s.repo.DeleteLevelRewardClaim(context.WithoutCancel(ctx), claim.ID); err != nil {
+		s.log.Errorw("failed to release level reward claim", "playerId", playerID, "level", level, "error", err)
+	}
+}
+
+func (s *serviceImpl) grantProgressRewards(ctx context.Context, playerID uuid.UUID) {
+	for _, hook := range s.rewardHooks {
+		if err := hook.GrantProgressRewards(ctx, playerID); err != nil {
+			s.log.Errorw("failed to grant progress rewards", "playerId", playerID, "error", err)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package player
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"mc-player-service/internal/config"
+	"mc-player-service/internal/repository/model"
+	"mc-player-service/internal/utils/experience"
+	"sort"
+	"time"
+)
+
+const seasonArchiveBatchSize = 500
+
+var ErrSeasonNotFound = errors.New("season does not exist")
+
+type SeasonProgress struct {
+	Season     config.Season
+	Experience int64
+	Level      int
+}
+
+// ArchiveEndedSeasons moves each player's experience for every season that has ended into the season history
+func (s *serviceImpl) ArchiveEndedSeasons(ctx context.Context) error {
+	now := time.Now()
+	for _, season := range s.expCfg.Seasons {
+		if !season.HasEnded(now) {
+			continue
+		}
+
+		archived := 0
+		for {
+			results, err := s.repo.GetUnarchivedSeasonResults(ctx, season.ID, seasonArchiveBatchSize)
+			if err != nil {
+				return fmt.Errorf("failed to get season results: %w", err)
+			}
+			if len(results) == 0 {
+				break
+			}
+
+			for i := range results {
+				results[i].ID = primitive.NewObjectID()
+				results[i].Level = experience.XPToLevel(int(results[i].Experience))
+			}
+
+			if err := s.repo.ArchiveSeasonResults(ctx, results); err != nil {
+				return fmt.Errorf("failed to archive season results: %w", err)
+			}
+			archived += len(results)
+		}
+
+		if archived > 0 {
+			s.log.Infow("archived season", "seasonId", season.ID, "players", archived)
+		}
+	}
+
+	return nil
+}
+
+// GetPlayerSeasons returns the player's progress in the current season (nil if no season is running)
+// and their results for past seasons, oldest first
+func (s *serviceImpl) GetPlayerSeasons(ctx context.Context, playerID uuid.UUID) (*SeasonProgress, []SeasonProgress, error) {
+	p, err := s.repo.GetPlayer(ctx, playerID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get player: %w", err)
+	}
+
+	results, err := s.repo.GetPlayerSeasonResults(ctx, playerID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get season results: %w", err)
+	}
+
+	now := time.Now()
+	var current *SeasonProgress
+	var past []SeasonProgress
+
+	for seasonID, xp := range p.SeasonExperience {
+		season, ok := s.expCfg.GetSeason(seasonID)
+		if !ok {
+			s.log.Warnw("player has experience for season that does not exist", "playerId", playerID, "seasonId", seasonID)
+			continue
+		}
+
+		progress := SeasonProgress{Season: season, Experience: xp, Level: experience.XPToLevel(int(xp))}
+		if season.IsActive(now) {
+			current = &progress
+		} else {
+			// The season has ended but hasn't been archived yet
+			past = append(past, progress)
+		}
+	}
+
+	if current == nil {
+		if season := s.expCfg.CurrentSeason(now); season != nil {
+			current = &SeasonProgress{Season: *season}
+		}
+	}
+
+	for _, result := range results {
+		season, ok := s.expCfg.GetSeason(result.SeasonID)
+		if !ok {
+			season = config.Season{ID: result.SeasonID}
+		}
+
+		past = append(past, SeasonProgress{Season: season, Experience: result.Experience, Level: result.Level})
+	}
+
+	sort.Slice(past, func(i, j int) bool {
+		return past[i].Season.Start.Before(past[j].Season.Start)
+	})
+
+	return current, past, nil
+}
+
+// GetSeasonLeaderboard returns the top players of a season, or of the current season if seasonID is empty
+func (s *serviceImpl) GetSeasonLeaderboard(ctx context.Context, seasonID string, limit int) (config.Season, []model.SeasonResult, error) {
+	now := time.Now()
+
+	var season config.Season
+	if seasonID == "" {
+		current := s.expCfg.CurrentSeason(now)
+		if current == nil {
+			return config.Season{}, nil, ErrSeasonNotFound
+		}
+		season = *current
+	} else {
+		var ok bool
+		if season, ok = s.expCfg.GetSeason(seasonID); !ok {
+			return config.Season{}, nil, ErrSeasonNotFound
+		}
+	}
+
+	var results []model.SeasonResult
+	var err error
+	if season.HasEnded(now) {
+		results, err = s.repo.GetArchivedSeasonLeaderboard(ctx, season.ID, limit)
+	} else {
+		results, err = s.repo.GetSeasonLeaderboard(ctx, season.ID, limit)
+	}
+	if err != nil {
+		return config.Season{}, nil, fmt.Errorf("failed to get season leaderboard: %w", err)
+	}
+
+	for i := range results {
+		results[i].Level = experience.XPToLevel(int(results[i].Experience))
+	}
+
+	return season, results, nil
+}
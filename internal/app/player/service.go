@@ -2,9 +2,11 @@ package player
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 	"go.uber.org/zap"
 	"mc-player-service/internal/config"
 	"mc-player-service/internal/repository"
@@ -20,46 +22,222 @@ type Service interface {
 	HandlePlayerDisconnect(ctx context.Context, time time.Time, playerID uuid.UUID, playerUsername string)
 	HandlePlayerServerSwitch(ctx context.Context, pID uuid.UUID, newServerID string)
 
-	AddExperienceByID(ctx context.Context, playerID uuid.UUID, reason string, amount int) (int, error)
+	// AddExperienceByID gives the player the amount of experience after all active event
+	// and booster multipliers have been applied. If the reason is capped or the player is close
+	// to the level cap, only the experience remaining under the cap is given.
+	AddExperienceByID(ctx context.Context, playerID uuid.UUID, reason string, amount int) (ExperienceResult, error)
+	// AddIdempotentExperienceByID functions like AddExperienceByID but returns ErrDuplicateGrant
+	// if the player has already been given experience with the idempotency key
+	AddIdempotentExperienceByID(ctx context.Context, playerID uuid.UUID, reason string, amount int, idempotencyKey string) (ExperienceResult, error)
+	// QueueFailedExperienceGrant stores an idempotent grant that failed so that RetryFailedExperienceGrants retries it
+	QueueFailedExperienceGrant(ctx context.Context, playerID uuid.UUID, reason string, amount int, idempotencyKey string, grantErr error) error
+	// RetryFailedExperienceGrants retries a batch of the queued grants that are due, backing off further each time a grant fails
+	RetryFailedExperienceGrants(ctx context.Context) error
+
+	// RevokeExperienceTransaction removes the experience given by the transaction, never dropping
+	// the player below zero, and records a compensating transaction
+	RevokeExperienceTransaction(ctx context.Context, transactionID primitive.ObjectID, reason string) (RevocationResult, error)
+	// RevokeExperienceByReason revokes every transaction for transactionReason created within [from, to)
+	RevokeExperienceByReason(ctx context.Context, transactionReason string, from time.Time, to time.Time, reason string) (RevocationResult, error)
+
+	// ArchiveEndedSeasons moves players' experience for seasons that have ended into the season history
+	ArchiveEndedSeasons(ctx context.Context) error
+	GetPlayerSeasons(ctx context.Context, playerID uuid.UUID) (current *SeasonProgress, past []SeasonProgress, err error)
+	// GetSeasonLeaderboard returns the top players of a season, or of the current season if seasonID is empty
+	GetSeasonLeaderboard(ctx context.Context, seasonID string, limit int) (config.Season, []model.SeasonResult, error)
+
+	GetPlayerLevel(ctx context.Context, playerID uuid.UUID) (LevelProgress, error)
+	// GetLevelTiers returns every configured tier ordered by MinLevel
+	GetLevelTiers() []config.LevelTier
+	// Prestige resets the experience of a player at the level cap and increments their prestige, returning the new prestige
+	Prestige(ctx context.Context, playerID uuid.UUID) (int, error)
+
+	// GetPlayerGameModes returns the player's experience and level in each configured game mode
+	GetPlayerGameModes(ctx context.Context, playerID uuid.UUID) ([]GameModeProgress, error)
+
+	AddExperienceBooster(ctx context.Context, playerID uuid.UUID, multiplier float64, source string,
+		expiresAt time.Time) (model.ExperienceBooster, error)
+	// GetExperienceBoosters returns the player's boosters that have not yet expired
+	GetExperienceBoosters(ctx context.Context, playerID uuid.UUID) ([]model.ExperienceBooster, error)
+	RemoveExperienceBooster(ctx context.Context, boosterID primitive.ObjectID) error
 }
 
 type serviceImpl struct {
-	log *zap.SugaredLogger
+	log    *zap.SugaredLogger
+	expCfg config.ExperienceConfig
 
 	repo    repository.PlayerReadWriter
 	kafkaW  KafkaWriter
 	webhook webhook.Webhook
+
+	rewardHooks []RewardHook
 }
 
-func NewService(log *zap.SugaredLogger, cfg config.Config, repo repository.PlayerReadWriter, kafkaW KafkaWriter) Service {
+func NewService(log *zap.SugaredLogger, cfg config.Config, repo repository.PlayerReadWriter, kafkaW KafkaWriter,
+	rewardHooks ...RewardHook) Service {
+
 	return &serviceImpl{
-		log:     log,
-		repo:    repo,
-		kafkaW:  kafkaW,
-		webhook: webhook.NewWebhook(cfg.DiscordWebhookUrl, log),
+		log:         log,
+		expCfg:      cfg.Experience,
+		repo:        repo,
+		kafkaW:      kafkaW,
+		webhook:     webhook.NewWebhook(cfg.DiscordWebhookUrl, log),
+		rewardHooks: rewardHooks,
 	}
 }
 
-func (s *serviceImpl) AddExperienceByID(ctx context.Context, playerID uuid.UUID, reason string, amount int) (int, error) {
-	newXP, err := s.repo.AddExperienceToPlayer(ctx, playerID, amount)
+type ExperienceResult struct {
+	// Requested the amount of experience after multipliers, before any caps
+	Requested int
+	// Granted the amount of experience the player actually received
+	Granted int
+	// CapReached true if Granted was reduced because the player hit a cap for the reason
+	CapReached bool
+	// LevelCapReached true if Granted was reduced because the player hit the level cap and must prestige
+	LevelCapReached bool
+	// TransactionID is primitive.NilObjectID if no transaction was recorded because nothing was granted
+	TransactionID primitive.ObjectID
+
+	NewExperience int
+	Prestige      int
+	// Tier the tier of the player's level after the grant, nil if the level is below every configured tier
+	Tier *config.LevelTier
+}
+
+var ErrDuplicateGrant = errors.New("experience has already been granted with this idempotency key")
+
+func (s *serviceImpl) AddExperienceByID(ctx context.Context, playerID uuid.UUID, reason string, amount int) (ExperienceResult, error) {
+	return s.addExperience(ctx, playerID, reason, amount, "")
+}
+
+func (s *serviceImpl) AddIdempotentExperienceByID(ctx context.Context, playerID uuid.UUID, reason string, amount int,
+	idempotencyKey string) (ExperienceResult, error) {
+
+	return s.addExperience(ctx, playerID, reason, amount, idempotencyKey)
+}
+
+func (s *serviceImpl) addExperience(ctx context.Context, playerID uuid.UUID, reason string, amount int,
+	idempotencyKey string) (ExperienceResult, error) {
+
+	if amount < 0 {
+		return ExperienceResult{}, ErrNegativeExperience
+	}
+
+	now := time.Now()
+
+	multipliers, err := s.getMultipliers(ctx, playerID, now)
+	if err != nil {
+		return ExperienceResult{}, fmt.Errorf("failed to get multipliers: %w", err)
+	}
+	requested := applyMultipliers(amount, multipliers)
+
+	granted := requested
+	remainingCap, err := s.getRemainingCap(ctx, playerID, reason, now)
 	if err != nil {
-		return 0, fmt.Errorf("failed to add experience to player: %w", err)
+		return ExperienceResult{}, fmt.Errorf("failed to get remaining cap: %w", err)
+	}
+	if remainingCap != -1 && remainingCap < granted {
+		granted = remainingCap
+	}
+
+	capReached := granted < requested
+
+	result := ExperienceResult{
+		Requested:  requested,
+		Granted:    granted,
+		CapReached: capReached,
+	}
+
+	var tracks model.ExperienceTracks
+	if season := s.expCfg.CurrentSeason(now); season != nil {
+		tracks.SeasonID = season.ID
+	}
+	if tracks.GameModeID, err = s.getGameModeID(ctx, playerID, reason); err != nil {
+		return ExperienceResult{}, fmt.Errorf("failed to get game mode: %w", err)
+	}
+
+	// Idempotent grants are always recorded so that a redelivery is recognised even if nothing was granted
+	recorded := granted != 0 || idempotencyKey != ""
+	if recorded {
+		// The transaction is created before the experience is added so that its idempotency key is claimed first.
+		// Its amount is lowered afterwards if the level cap means less is added.
+		result.TransactionID = primitive.NewObjectID()
+		if err := s.repo.CreateExperienceTransaction(ctx, model.ExperienceTransaction{
+			ID:             result.TransactionID,
+			PlayerID:       playerID,
+			BaseAmount:     int64(amount),
+			Multipliers:    multipliers,
+			Amount:         int64(granted),
+			Capped:         capReached,
+			Reason:         reason,
+			SeasonID:       tracks.SeasonID,
+			GameModeID:     tracks.GameModeID,
+			IdempotencyKey: idempotencyKey,
+		}); err != nil {
+			if idempotencyKey != "" && mongo.IsDuplicateKeyError(err) {
+				return ExperienceResult{}, ErrDuplicateGrant
+			}
+			return ExperienceResult{}, fmt.Errorf("failed to create experience transaction: %w", err)
+		}
 	}
 
-	if err := s.repo.CreateExperienceTransaction(ctx, model.ExperienceTransaction{
-		ID:       primitive.NewObjectID(),
-		PlayerID: playerID,
-		Amount:   int64(amount),
-		Reason:   reason,
-	}); err != nil {
-		return 0, fmt.Errorf("failed to create experience transaction: %w", err)
+	// The level cap is applied by the update so that concurrent grants can't take the player past it.
+	// Adding 0 still returns the player's current experience.
+	before, added, err := s.repo.AddExperienceToPlayer(ctx, playerID, granted, s.expCfg.LevelCapXP(), tracks)
+	if err != nil {
+		if recorded {
+			// Releases the idempotency key so that the grant can be retried
+			if err := s.repo.DeleteExperienceTransaction(context.WithoutCancel(ctx), result.TransactionID); err != nil {
+				s.log.Errorw("failed to delete experience transaction", "transactionId", result.TransactionID, "error", err)
+			}
+		}
+		return ExperienceResult{}, fmt.Errorf("failed to add experience to player: %w", err)
+	}
+
+	oldXP := int(before.Experience)
+	newXP := oldXP + added
+	result.Prestige = before.Prestige
+	result.NewExperience = newXP
+	result.Tier = s.tierForLevel(experience.XPToLevel(newXP))
+
+	if added < granted {
+		result.Granted = added
+		result.LevelCapReached = true
+		s.recordLevelCap(ctx, result.TransactionID, added, idempotencyKey)
+		if added == 0 && idempotencyKey == "" {
+			result.TransactionID = primitive.NilObjectID
+		}
+	}
+
+	if added == 0 {
+		return result, nil
 	}
 
-	oldXP := newXP - amount
 	oldLevel := experience.XPToLevel(oldXP)
 	newLevel := experience.XPToLevel(newXP)
 
 	s.kafkaW.PlayerExperienceChange(ctx, playerID, reason, oldXP, newXP, oldLevel, newLevel)
 
-	return newXP, nil
+	if newLevel > oldLevel {
+		s.grantLevelRewards(ctx, playerID, oldLevel, newLevel)
+		s.grantProgressRewards(ctx, playerID)
+	}
+
+	return result, nil
+}
+
+// recordLevelCap lowers the amount of the grant's transaction to what was added under the level cap.
+// A transaction that no longer records anything is removed, unless it's needed for its idempotency key.
+func (s *serviceImpl) recordLevelCap(ctx context.Context, transactionID primitive.ObjectID, added int, idempotencyKey string) {
+	ctx = context.WithoutCancel(ctx)
+
+	var err error
+	if added == 0 && idempotencyKey == "" {
+		err = s.repo.DeleteExperienceTransaction(ctx, transactionID)
+	} else {
+		err = s.repo.SetExperienceTransactionAmount(ctx, transactionID, int64(added), true)
+	}
+	if err != nil {
+		s.log.Errorw("failed to record level cap on experience transaction", "transactionId", transactionID, "error", err)
+	}
 }
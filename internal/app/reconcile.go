@@ -0,0 +1,36 @@
+package app
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"mc-player-service/internal/app/player"
+	"mc-player-service/internal/config"
+	"mc-player-service/internal/repository"
+	"sync"
+)
+
+// ReconcileExperience runs a one-off reconciliation of player experience against the transaction ledger.
+// Without repair it is a dry run that only reports mismatches.
+func ReconcileExperience(ctx context.Context, cfg config.Config, log *zap.SugaredLogger, repair bool) error {
+	repoWg := &sync.WaitGroup{}
+	repoCtx, repoCancel := context.WithCancel(ctx)
+	defer func() {
+		repoCancel()
+		repoWg.Wait()
+	}()
+
+	repo, err := repository.NewMongoRepository(repoCtx, log, repoWg, cfg.MongoDB)
+	if err != nil {
+		return err
+	}
+
+	summary, err := player.ReconcileExperience(ctx, log, repo, repair)
+	if err != nil {
+		return err
+	}
+
+	log.Infow("experience reconciliation complete", "dryRun", !repair, "mismatched", summary.Mismatched,
+		"repaired", summary.Repaired, "skipped", summary.Skipped, "netDifference", summary.NetDifference)
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package app
+
+import (
+	"context"
+	"errors"
+	permpb "github.com/emortalmc/proto-specs/gen/go/grpc/permission"
+	"go.uber.org/zap"
+	googlegrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"io"
+	"mc-player-service/internal/app/badge"
+	"mc-player-service/internal/config"
+	kafkaWriter "mc-player-service/internal/kafka/writer"
+	"mc-player-service/internal/repository"
+	"sync"
+	"time"
+)
+
+var ErrPermissionServiceNotConfigured = errors.New("permissionService.address is not configured")
+
+func newRoleSource(cfg config.PermissionServiceConfig) (badge.RoleSource, io.Closer, error) {
+	conn, err := googlegrpc.Dial(cfg.Address, googlegrpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return badge.NewPermissionRoleSource(permpb.NewPermissionServiceClient(conn)), conn, nil
+}
+
+// validateBadgeRoles fails if an automatic grant uses a role that doesn't exist.
+// The check is skipped if the roles can't be fetched so the permission service being down doesn't prevent startup.
+func validateBadgeRoles(ctx context.Context, log *zap.SugaredLogger, roles badge.RoleSource, badgeCfg config.BadgeConfig) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	knownRoles, err := roles.GetAllRoles(ctx)
+	if err != nil {
+		log.Warnw("failed to get roles, skipping badge role validation", "error", err)
+		return
+	}
+
+	if err := badgeCfg.Validate(knownRoles); err != nil {
+		log.Fatalw("invalid badge config", "error", err)
+	}
+}
+
+func resyncRoleBadgesPeriodically(ctx context.Context, wg *sync.WaitGroup, log *zap.SugaredLogger, interval time.Duration,
+	badgeSvc badge.Service) {
+
+	runPeriodically(ctx, wg, interval, func(ctx context.Context) {
+		report, err := badgeSvc.ResyncRoleBadges(ctx, false)
+		if err != nil {
+			log.Errorw("failed to resync role badges", "error", err)
+			return
+		}
+
+		log.Infow("resynced role badges", "playersChecked", report.PlayersChecked,
+			"playersSkipped", report.PlayersSkipped, "playersFailed", report.PlayersFailed, "changes", len(report.Changes))
+	})
+}
+
+// ResyncRoleBadges runs a one-off resync of permission role badges, logging every change.
+// Without apply it is a dry run that only reports the changes that would be made.
+func ResyncRoleBadges(ctx context.Context, cfg config.Config, log *zap.SugaredLogger, apply bool) error {
+	if cfg.PermissionService.Address == "" {
+		return ErrPermissionServiceNotConfigured
+	}
+
+	roles, conn, err := newRoleSource(cfg.PermissionService)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	repoWg := &sync.WaitGroup{}
+	repoCtx, repoCancel := context.WithCancel(ctx)
+	defer func() {
+		repoCancel()
+		repoWg.Wait()
+	}()
+
+	repo, err := repository.NewMongoRepository(repoCtx, log, repoWg, cfg.MongoDB)
+	if err != nil {
+		return err
+	}
+
+	// The notifier is closed before the repository so that queued badge events are flushed
+	notifierWg := &sync.WaitGroup{}
+	notifierCtx, notifierCancel := context.WithCancel(ctx)
+	defer func() {
+		notifierCancel()
+		notifierWg.Wait()
+	}()
+	notifier := kafkaWriter.NewKafkaNotifier(notifierCtx, notifierWg, cfg.Kafka, log)
+
+	badgeCfg, err := loadBadgeConfig(ctx, log, cfg, repo, notifier)
+	if err != nil {
+		return err
+	}
+
+	badgeSvc := badge.NewService(log, repo, repo, config.NewBadgeConfigHolder(badgeCfg), notifier, roles)
+
+	report, err := badgeSvc.ResyncRoleBadges(ctx, !apply)
+	if err != nil {
+		return err
+	}
+
+	for _, change := range report.Changes {
+		log.Infow("role badge change", "dryRun", !apply, "playerId", change.PlayerID, "badgeId", change.BadgeID, "added", change.Added)
+	}
+	log.Infow("role badge resync complete", "dryRun", !apply, "playersChecked", report.PlayersChecked,
+		"playersSkipped", report.PlayersSkipped, "playersFailed", report.PlayersFailed, "changes", len(report.Changes))
+
+	return nil
+}
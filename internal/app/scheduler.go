@@ -0,0 +1,28 @@
+package app
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// runPeriodically calls fn immediately and then every interval until ctx is cancelled
+func runPeriodically(ctx context.Context, wg *sync.WaitGroup, interval time.Duration, fn func(ctx context.Context)) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		t := time.NewTicker(interval)
+		defer t.Stop()
+
+		fn(ctx)
+		for {
+			select {
+			case <-t.C:
+				fn(ctx)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
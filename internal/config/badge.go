@@ -1,29 +1,103 @@
 package config
 
 import (
+	"errors"
 	pbmodel "github.com/emortalmc/proto-specs/gen/go/model/badge"
+	"github.com/fsnotify/fsnotify"
 	"github.com/spf13/viper"
+	"mc-player-service/gen/go/grpc/badgeadmin"
+	"os"
+	"slices"
 	"strings"
+	"sync/atomic"
+	"time"
 )
 
+const DefaultBadgeConfigPath = "./badge-config"
+
 type BadgeConfig struct {
 	Badges map[string]*Badge
+	// Groups badges in a group are tiers of each other, so a player only keeps the highest tier they're given
+	Groups map[string]*BadgeGroup
+
+	// RarityLore if set, is appended to each badge's GUI item lore in GetBadges responses.
+	// {owners} is replaced with the number of players that own the badge and {percentage} with the
+	// percentage of all players, e.g. "<i:false><gray>Owned by {percentage}% of players</gray>"
+	RarityLore string
+
+	// DefaultLocale the locale of badge text outside a badge's Locales, e.g. "en_us" (the default)
+	DefaultLocale string
 }
 
-type Badge struct {
-	Id       string
-	Priority int
-	Required bool
+// BadgeConfigHolder holds the current BadgeConfig so that it can be swapped for all consumers when it is reloaded
+type BadgeConfigHolder struct {
+	cfg atomic.Pointer[BadgeConfig]
+}
+
+func NewBadgeConfigHolder(cfg BadgeConfig) *BadgeConfigHolder {
+	h := &BadgeConfigHolder{}
+	h.cfg.Store(&cfg)
+
+	return h
+}
 
+// Get returns the current config. It is shared so must not be modified.
+func (h *BadgeConfigHolder) Get() *BadgeConfig {
+	return h.cfg.Load()
+}
+
+// Swap replaces the current config, returning the previous one
+func (h *BadgeConfigHolder) Swap(cfg BadgeConfig) *BadgeConfig {
+	return h.cfg.Swap(&cfg)
+}
+
+type BadgeGroup struct {
+	Id           string
 	FriendlyName string
-	ChatString   string
+	// Badges IDs of the badges in the group, lowest tier first
+	Badges []string
+}
+
+func (g *BadgeGroup) ToProto() *badgeadmin.BadgeGroup {
+	return &badgeadmin.BadgeGroup{
+		Id:           g.Id,
+		FriendlyName: g.FriendlyName,
+		BadgeIds:     g.Badges,
+	}
+}
+
+// GroupOf returns the group the badge is in and its tier within the group, 0 being the lowest
+func (c *BadgeConfig) GroupOf(badgeID string) (*BadgeGroup, int, bool) {
+	for _, group := range c.Groups {
+		if tier := slices.Index(group.Badges, badgeID); tier != -1 {
+			return group, tier, true
+		}
+	}
+
+	return nil, 0, false
+}
+
+// Badge the bson tags are used when the badge is stored in the badge catalogue
+type Badge struct {
+	Id       string `bson:"_id"`
+	Priority int    `bson:"priority"`
+	Required bool   `bson:"required"`
+	// Forced the badge is made active whenever it's owned, overriding the badge the player chose.
+	// If the player owns several, the forced badge with the highest priority is active.
+	Forced bool `bson:"forced,omitempty"`
+
+	FriendlyName string `bson:"friendlyName"`
+	ChatString   string `bson:"chatString"`
 
 	// HoverText is parsed on the client side as MiniMessage
-	HoverText []string
+	HoverText []string `bson:"hoverText"`
+
+	GuiItem *BadgeGuiItem `bson:"guiItem"`
 
-	GuiItem *BadgeGuiItem
+	AutomaticGrants *BadgeAutomaticGrants `bson:"automaticGrants,omitempty"`
 
-	AutomaticGrants *BadgeAutomaticGrants
+	// Locales translations of the badge's text keyed by locale, e.g. "de_de". See BadgeConfig.Localize.
+	Locales map[string]*BadgeLocale `bson:"locales,omitempty"`
 }
 
 func (b *Badge) GetFormattedHoverText() string {
@@ -44,10 +118,15 @@ func (b *Badge) ToProto() *pbmodel.Badge {
 
 type BadgeGuiItem struct {
 	// Display whether the item should be displayed in the GUI if not unlocked
-	Display     bool
-	Material    string
-	DisplayName string
-	Lore        []string
+	Display     bool     `bson:"display"`
+	Material    string   `bson:"material"`
+	DisplayName string   `bson:"displayName"`
+	Lore        []string `bson:"lore"`
+}
+
+// isHidden returns true if the item is never shown in the GUI, so doesn't need a display name
+func (b *BadgeGuiItem) isHidden() bool {
+	return b.Material == "minecraft:air" || !b.Display
 }
 
 func (b *BadgeGuiItem) ToProto() *pbmodel.Badge_GuiItem {
@@ -59,24 +138,146 @@ func (b *BadgeGuiItem) ToProto() *pbmodel.Badge_GuiItem {
 }
 
 type BadgeAutomaticGrants struct {
-	GitHubPullRequests *int // todo
-	PermissionRole     *string
+	// GitHubPullRequests the number of merged pull requests to the configured orgs needed for the badge.
+	// A player is only given the badge with the highest threshold they meet.
+	GitHubPullRequests *int `bson:"gitHubPullRequests,omitempty"`
+
+	// PermissionRole grants the badge to players with the role. Shorthand for a single PermissionRoles entry.
+	PermissionRole *string `bson:"permissionRole,omitempty"`
+	// PermissionRoles grants the badge to players with any (or all, see PermissionRoleMatch) of the roles
+	PermissionRoles []string `bson:"permissionRoles,omitempty"`
+	// PermissionRoleMatch either RoleMatchAny (the default) or RoleMatchAll
+	PermissionRoleMatch string `bson:"permissionRoleMatch,omitempty"`
+
+	// Criteria grants the badge to players that meet every criterion set
+	Criteria *BadgeCriteria `bson:"criteria,omitempty"`
+}
+
+// BadgeCriteria criteria are evaluated when a player connects, disconnects, levels up or is given or loses a badge,
+// as well as periodically for every player. Unset criteria are ignored.
+type BadgeCriteria struct {
+	// MinLevel the player's current level, which is reset by prestiging
+	MinLevel    *int           `bson:"minLevel,omitempty"`
+	MinPlaytime *time.Duration `bson:"minPlaytime,omitempty"`
+	// FirstLoginBefore the player must have first logged in before this time
+	FirstLoginBefore *time.Time `bson:"firstLoginBefore,omitempty"`
+	// MinLoginStreak the number of consecutive days (UTC) the player has logged in on.
+	// A streak ends if the player doesn't log in for a whole day.
+	MinLoginStreak *int `bson:"minLoginStreak,omitempty"`
+	// OwnsBadges the player must own every one of these badges
+	OwnsBadges []string `bson:"ownsBadges,omitempty"`
+
+	// Revoke removes the badge from players that no longer meet the criteria. By default, the badge is kept.
+	Revoke bool `bson:"revoke,omitempty"`
+}
+
+// IsEmpty returns true if no criteria are set, in which case the badge is never granted for them
+func (c *BadgeCriteria) IsEmpty() bool {
+	return c.MinLevel == nil && c.MinPlaytime == nil && c.FirstLoginBefore == nil && c.MinLoginStreak == nil &&
+		len(c.OwnsBadges) == 0
+}
+
+const (
+	RoleMatchAny = "any"
+	RoleMatchAll = "all"
+)
+
+// Roles returns every role that PermissionRole and PermissionRoles grant the badge for
+func (g *BadgeAutomaticGrants) Roles() []string {
+	if g == nil {
+		return nil
+	}
+
+	roles := g.PermissionRoles
+	if g.PermissionRole != nil && !slices.Contains(roles, *g.PermissionRole) {
+		roles = append(slices.Clip(roles), *g.PermissionRole)
+	}
+
+	return roles
+}
+
+// QualifiesForRoles returns true if a player with the given roles should own the badge.
+// Badges without any roles never qualify.
+func (g *BadgeAutomaticGrants) QualifiesForRoles(playerRoles []string) bool {
+	roles := g.Roles()
+	if len(roles) == 0 {
+		return false
+	}
+
+	if g.PermissionRoleMatch == RoleMatchAll {
+		for _, role := range roles {
+			if !slices.Contains(playerRoles, role) {
+				return false
+			}
+		}
+		return true
+	}
+
+	for _, role := range roles {
+		if slices.Contains(playerRoles, role) {
+			return true
+		}
+	}
+	return false
 }
 
 func LoadBadgeConfig() (config BadgeConfig, err error) {
+	return LoadBadgeConfigFrom(DefaultBadgeConfigPath)
+}
+
+// LoadBadgeConfigFrom loads the badge config from path, which may be a config file or a directory containing config.yaml
+func LoadBadgeConfigFrom(path string) (config BadgeConfig, err error) {
 	v := viper.New()
-	v.AddConfigPath("./badge-config")
-	v.SetConfigName("config")
+	if info, statErr := os.Stat(path); statErr == nil && !info.IsDir() {
+		v.SetConfigFile(path)
+	} else {
+		v.AddConfigPath(path)
+		v.SetConfigName("config")
+	}
 
 	err = v.ReadInConfig()
 	if err != nil {
 		return
 	}
 
-	err = v.Unmarshal(&config)
+	err = v.Unmarshal(&config, viper.DecodeHook(decodeHook))
 	if err != nil {
 		return
 	}
 
 	return
 }
+
+var ErrBadgeConfigInvalid = errors.New("badge config is invalid")
+
+// WatchBadgeConfig calls onChange with the new config each time the badge config file changes.
+// If the new config can't be loaded, onError is called instead and the change is ignored.
+// The config isn't validated, as with the badge catalogue the file doesn't contain every badge.
+func WatchBadgeConfig(onChange func(cfg BadgeConfig), onError func(err error)) error {
+	v := newBadgeViper()
+	if err := v.ReadInConfig(); err != nil {
+		return err
+	}
+
+	v.OnConfigChange(func(fsnotify.Event) {
+		// Load a fresh copy rather than using v, which keeps the old values if the file can't be read
+		cfg, err := LoadBadgeConfig()
+		if err != nil {
+			onError(err)
+			return
+		}
+
+		onChange(cfg)
+	})
+	v.WatchConfig()
+
+	return nil
+}
+
+func newBadgeViper() *viper.Viper {
+	v := viper.New()
+	v.AddConfigPath(DefaultBadgeConfigPath)
+	v.SetConfigName("config")
+
+	return v
+}
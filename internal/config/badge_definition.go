@@ -0,0 +1,162 @@
+package config
+
+import (
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"mc-player-service/gen/go/grpc/badgeadmin"
+)
+
+// ToDefinitionProto unlike ToProto, returns the full definition of the badge for editing
+func (b *Badge) ToDefinitionProto() *badgeadmin.BadgeDefinition {
+	definition := &badgeadmin.BadgeDefinition{
+		Id:           b.Id,
+		Priority:     int64(b.Priority),
+		Required:     b.Required,
+		Forced:       b.Forced,
+		FriendlyName: b.FriendlyName,
+		ChatString:   b.ChatString,
+		HoverText:    b.HoverText,
+	}
+
+	if b.GuiItem != nil {
+		definition.GuiItem = &badgeadmin.BadgeGuiItem{
+			Display:     b.GuiItem.Display,
+			Material:    b.GuiItem.Material,
+			DisplayName: b.GuiItem.DisplayName,
+			Lore:        b.GuiItem.Lore,
+		}
+	}
+
+	if grants := b.AutomaticGrants; grants != nil {
+		definition.AutomaticGrants = &badgeadmin.BadgeAutomaticGrants{
+			PermissionRoles:     grants.Roles(),
+			PermissionRoleMatch: grants.PermissionRoleMatch,
+		}
+		if grants.GitHubPullRequests != nil {
+			pullRequests := int32(*grants.GitHubPullRequests)
+			definition.AutomaticGrants.GithubPullRequests = &pullRequests
+		}
+		if grants.Criteria != nil {
+			definition.AutomaticGrants.Criteria = grants.Criteria.toProto()
+		}
+	}
+
+	if len(b.Locales) > 0 {
+		definition.Locales = make(map[string]*badgeadmin.BadgeLocale, len(b.Locales))
+		for locale, translation := range b.Locales {
+			definition.Locales[locale] = &badgeadmin.BadgeLocale{
+				FriendlyName:   translation.FriendlyName,
+				HoverText:      translation.HoverText,
+				GuiDisplayName: translation.GuiDisplayName,
+				GuiLore:        translation.GuiLore,
+			}
+		}
+	}
+
+	return definition
+}
+
+func (c *BadgeCriteria) toProto() *badgeadmin.BadgeCriteria {
+	criteria := &badgeadmin.BadgeCriteria{
+		OwnsBadges: c.OwnsBadges,
+		Revoke:     c.Revoke,
+	}
+
+	if c.MinLevel != nil {
+		minLevel := int32(*c.MinLevel)
+		criteria.MinLevel = &minLevel
+	}
+	if c.MinPlaytime != nil {
+		criteria.MinPlaytime = durationpb.New(*c.MinPlaytime)
+	}
+	if c.FirstLoginBefore != nil {
+		criteria.FirstLoginBefore = timestamppb.New(*c.FirstLoginBefore)
+	}
+	if c.MinLoginStreak != nil {
+		minLoginStreak := int32(*c.MinLoginStreak)
+		criteria.MinLoginStreak = &minLoginStreak
+	}
+
+	return criteria
+}
+
+// BadgeFromDefinitionProto is the inverse of Badge.ToDefinitionProto. The badge is not validated.
+func BadgeFromDefinitionProto(definition *badgeadmin.BadgeDefinition) Badge {
+	badge := Badge{
+		Id:           definition.Id,
+		Priority:     int(definition.Priority),
+		Required:     definition.Required,
+		Forced:       definition.Forced,
+		FriendlyName: definition.FriendlyName,
+		ChatString:   definition.ChatString,
+		HoverText:    definition.HoverText,
+	}
+
+	if item := definition.GuiItem; item != nil {
+		badge.GuiItem = &BadgeGuiItem{
+			Display:     item.Display,
+			Material:    item.Material,
+			DisplayName: item.DisplayName,
+			Lore:        item.Lore,
+		}
+	}
+
+	if grants := definition.AutomaticGrants; grants != nil {
+		badge.AutomaticGrants = &BadgeAutomaticGrants{
+			PermissionRoles:     grants.PermissionRoles,
+			PermissionRoleMatch: grants.PermissionRoleMatch,
+		}
+		if grants.GithubPullRequests != nil {
+			pullRequests := int(*grants.GithubPullRequests)
+			badge.AutomaticGrants.GitHubPullRequests = &pullRequests
+		}
+		if grants.Criteria != nil {
+			badge.AutomaticGrants.Criteria = criteriaFromProto(grants.Criteria)
+		}
+	}
+
+	if len(definition.Locales) > 0 {
+		badge.Locales = make(map[string]*BadgeLocale, len(definition.Locales))
+		for locale, translation := range definition.Locales {
+			if translation == nil {
+				translation = &badgeadmin.BadgeLocale{}
+			}
+
+			// Normalized so that e.g. "de-DE" is stored as "de_de"
+			badge.Locales[NormalizeLocale(locale)] = &BadgeLocale{
+				FriendlyName:   translation.FriendlyName,
+				HoverText:      translation.HoverText,
+				GuiDisplayName: translation.GuiDisplayName,
+				GuiLore:        translation.GuiLore,
+			}
+		}
+	}
+
+	return badge
+}
+
+func criteriaFromProto(proto *badgeadmin.BadgeCriteria) *BadgeCriteria {
+	criteria := &BadgeCriteria{
+		OwnsBadges: proto.OwnsBadges,
+		Revoke:     proto.Revoke,
+	}
+
+	if proto.MinLevel != nil {
+		minLevel := int(*proto.MinLevel)
+		criteria.MinLevel = &minLevel
+	}
+	if proto.MinPlaytime != nil {
+		minPlaytime := proto.MinPlaytime.AsDuration()
+		criteria.MinPlaytime = &minPlaytime
+	}
+	if proto.FirstLoginBefore != nil {
+		firstLoginBefore := proto.FirstLoginBefore.AsTime()
+		criteria.FirstLoginBefore = &firstLoginBefore
+	}
+	if proto.MinLoginStreak != nil {
+		minLoginStreak := int(*proto.MinLoginStreak)
+		criteria.MinLoginStreak = &minLoginStreak
+	}
+
+	return criteria
+}
@@ -0,0 +1,107 @@
+package config
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// DefaultBadgeLocale the locale of badge text if BadgeConfig.DefaultLocale isn't set
+const DefaultBadgeLocale = "en_us"
+
+// localePattern matches normalized locales such as "de" or "de_de"
+var localePattern = regexp.MustCompile(`^[a-z]{2,3}(_[a-z0-9]{2,8})?$`)
+
+// BadgeLocale translations of a badge's text. Text that isn't translated falls back to the default locale.
+type BadgeLocale struct {
+	FriendlyName string `bson:"friendlyName,omitempty"`
+	// HoverText is parsed on the client side as MiniMessage
+	HoverText      []string `bson:"hoverText,omitempty"`
+	GuiDisplayName string   `bson:"guiDisplayName,omitempty"`
+	GuiLore        []string `bson:"guiLore,omitempty"`
+}
+
+func (l *BadgeLocale) IsEmpty() bool {
+	return l.FriendlyName == "" && len(l.HoverText) == 0 && l.GuiDisplayName == "" && len(l.GuiLore) == 0
+}
+
+// NormalizeLocale converts a locale such as "en-US" to the form locales are keyed by, "en_us".
+// Config keys are lowercased when the config is read, so locales are always lowercase.
+func NormalizeLocale(locale string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(locale)), "-", "_")
+}
+
+// GetDefaultLocale returns the normalized locale of the text that isn't in a badge's Locales
+func (c *BadgeConfig) GetDefaultLocale() string {
+	if c.DefaultLocale == "" {
+		return DefaultBadgeLocale
+	}
+
+	return NormalizeLocale(c.DefaultLocale)
+}
+
+// Localize returns the badge with its text in the locale. If the badge isn't translated to the locale,
+// a translation to another variant of the same language is used, e.g. "de_at" for "de_de".
+// The badge itself is returned if there is no translation or the locale is empty, so it must not be modified.
+func (c *BadgeConfig) Localize(b *Badge, locale string) *Badge {
+	translation := c.findTranslation(b, NormalizeLocale(locale))
+	if translation == nil {
+		return b
+	}
+
+	localized := *b
+	if translation.FriendlyName != "" {
+		localized.FriendlyName = translation.FriendlyName
+	}
+	if len(translation.HoverText) > 0 {
+		localized.HoverText = translation.HoverText
+	}
+
+	if b.GuiItem != nil && (translation.GuiDisplayName != "" || len(translation.GuiLore) > 0) {
+		guiItem := *b.GuiItem
+		if translation.GuiDisplayName != "" {
+			guiItem.DisplayName = translation.GuiDisplayName
+		}
+		if len(translation.GuiLore) > 0 {
+			guiItem.Lore = translation.GuiLore
+		}
+		localized.GuiItem = &guiItem
+	}
+
+	return &localized
+}
+
+func (c *BadgeConfig) findTranslation(b *Badge, locale string) *BadgeLocale {
+	if locale == "" || len(b.Locales) == 0 {
+		return nil
+	}
+
+	if translation, ok := b.Locales[locale]; ok {
+		return translation
+	}
+
+	// The default text is preferred over a translation to another variant of the default locale's language
+	language := localeLanguage(locale)
+	if language == localeLanguage(c.GetDefaultLocale()) {
+		return nil
+	}
+
+	keys := make([]string, 0, len(b.Locales))
+	for key := range b.Locales {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys) // Picks the same variant every time
+
+	for _, key := range keys {
+		if localeLanguage(key) == language {
+			return b.Locales[key]
+		}
+	}
+
+	return nil
+}
+
+func localeLanguage(locale string) string {
+	language, _, _ := strings.Cut(locale, "_")
+	return language
+}
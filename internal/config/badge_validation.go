@@ -0,0 +1,217 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Validate checks every badge and returns all problems found joined together, or nil if the config is valid.
+// Automatic grant roles are only checked against knownRoles if it is not nil.
+func (c BadgeConfig) Validate(knownRoles []string) error {
+	ids := make([]string, 0, len(c.Badges))
+	for id := range c.Badges {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids) // Keeps the reported problems in a stable order
+
+	var errs []error
+	if !localePattern.MatchString(c.GetDefaultLocale()) {
+		errs = append(errs, fmt.Errorf("defaultLocale %q is not a locale such as en_us", c.DefaultLocale))
+	}
+
+	priorities := make(map[int]string, len(c.Badges))
+	for _, key := range ids {
+		badge := c.Badges[key]
+		if badge == nil {
+			errs = append(errs, fmt.Errorf("badge %s: is empty", key))
+			continue
+		}
+
+		for _, err := range badge.validate(key, knownRoles) {
+			errs = append(errs, fmt.Errorf("badge %s: %w", key, err))
+		}
+		for _, err := range c.validateOwnedBadges(key, badge) {
+			errs = append(errs, fmt.Errorf("badge %s: %w", key, err))
+		}
+		for _, err := range c.validateLocales(badge) {
+			errs = append(errs, fmt.Errorf("badge %s: %w", key, err))
+		}
+
+		if other, ok := priorities[badge.Priority]; ok {
+			errs = append(errs, fmt.Errorf("badge %s: priority %d is also used by %s", key, badge.Priority, other))
+		} else {
+			priorities[badge.Priority] = key
+		}
+	}
+
+	errs = append(errs, c.validateGroups()...)
+
+	return errors.Join(errs...)
+}
+
+func (c BadgeConfig) validateGroups() []error {
+	ids := make([]string, 0, len(c.Groups))
+	for id := range c.Groups {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var errs []error
+	groupOf := make(map[string]string)
+	for _, key := range ids {
+		group := c.Groups[key]
+		if group == nil {
+			errs = append(errs, fmt.Errorf("group %s: is empty", key))
+			continue
+		}
+
+		if group.Id != key {
+			errs = append(errs, fmt.Errorf("group %s: id %q does not match its key (ids must be lowercase)", key, group.Id))
+		}
+		if strings.TrimSpace(group.FriendlyName) == "" {
+			errs = append(errs, fmt.Errorf("group %s: friendlyName is required", key))
+		}
+		if len(group.Badges) < 2 {
+			errs = append(errs, fmt.Errorf("group %s: must contain at least 2 badges", key))
+		}
+
+		for _, badgeID := range group.Badges {
+			if _, ok := c.Badges[badgeID]; !ok {
+				errs = append(errs, fmt.Errorf("group %s: badge %s does not exist", key, badgeID))
+			} else if other, ok := groupOf[badgeID]; ok {
+				errs = append(errs, fmt.Errorf("group %s: badge %s is also in %s", key, badgeID, other))
+			} else {
+				groupOf[badgeID] = key
+			}
+		}
+	}
+
+	return errs
+}
+
+func (b *Badge) validate(key string, knownRoles []string) []error {
+	var errs []error
+
+	// Keys are lowercased when the config is read, so an id with uppercase characters can never match
+	if b.Id != key {
+		errs = append(errs, fmt.Errorf("id %q does not match its key (ids must be lowercase)", b.Id))
+	}
+	if b.Priority <= 0 {
+		errs = append(errs, errors.New("priority must be greater than 0"))
+	}
+
+	if strings.TrimSpace(b.FriendlyName) == "" {
+		errs = append(errs, errors.New("friendlyName is required"))
+	}
+	if strings.TrimSpace(b.ChatString) == "" {
+		errs = append(errs, errors.New("chatString is required"))
+	}
+	if strings.TrimSpace(b.GetFormattedHoverText()) == "" {
+		errs = append(errs, errors.New("hoverText is required"))
+	}
+
+	if b.GuiItem == nil {
+		errs = append(errs, errors.New("guiItem is required"))
+	} else {
+		if strings.TrimSpace(b.GuiItem.Material) == "" {
+			errs = append(errs, errors.New("guiItem.material is required"))
+		}
+		if strings.TrimSpace(b.GuiItem.DisplayName) == "" && !b.GuiItem.isHidden() {
+			errs = append(errs, errors.New("guiItem.displayName is required"))
+		}
+	}
+
+	if grants := b.AutomaticGrants; grants != nil {
+		if grants.GitHubPullRequests != nil && *grants.GitHubPullRequests <= 0 {
+			errs = append(errs, errors.New("automaticGrants.gitHubPullRequests must be greater than 0"))
+		}
+
+		for _, role := range grants.Roles() {
+			if role == "" {
+				errs = append(errs, errors.New("automaticGrants permission roles must not be empty"))
+			} else if knownRoles != nil && !slices.Contains(knownRoles, role) {
+				errs = append(errs, fmt.Errorf("automaticGrants permission role %s is not a known role", role))
+			}
+		}
+
+		switch grants.PermissionRoleMatch {
+		case "", RoleMatchAny, RoleMatchAll:
+		default:
+			errs = append(errs, fmt.Errorf("automaticGrants.permissionRoleMatch must be %s or %s", RoleMatchAny, RoleMatchAll))
+		}
+
+		if criteria := grants.Criteria; criteria != nil {
+			errs = append(errs, criteria.validate()...)
+
+			// Role resyncs would remove the badge from players that earned it through the criteria
+			if len(grants.Roles()) > 0 {
+				errs = append(errs, errors.New("automaticGrants.criteria can't be combined with permission roles"))
+			}
+		}
+	}
+
+	return errs
+}
+
+func (c *BadgeCriteria) validate() []error {
+	var errs []error
+
+	if c.IsEmpty() {
+		errs = append(errs, errors.New("automaticGrants.criteria must set at least one criterion"))
+	}
+	if c.MinLevel != nil && *c.MinLevel <= 0 {
+		errs = append(errs, errors.New("automaticGrants.criteria.minLevel must be greater than 0"))
+	}
+	if c.MinPlaytime != nil && *c.MinPlaytime <= 0 {
+		errs = append(errs, errors.New("automaticGrants.criteria.minPlaytime must be greater than 0"))
+	}
+	if c.MinLoginStreak != nil && *c.MinLoginStreak <= 0 {
+		errs = append(errs, errors.New("automaticGrants.criteria.minLoginStreak must be greater than 0"))
+	}
+
+	return errs
+}
+
+// validateOwnedBadges checks the badges required by the badge's criteria exist
+func (c BadgeConfig) validateOwnedBadges(key string, b *Badge) []error {
+	if b.AutomaticGrants == nil || b.AutomaticGrants.Criteria == nil {
+		return nil
+	}
+
+	var errs []error
+	for _, owned := range b.AutomaticGrants.Criteria.OwnsBadges {
+		if owned == key {
+			errs = append(errs, errors.New("automaticGrants.criteria.ownsBadges must not contain the badge itself"))
+		} else if _, ok := c.Badges[owned]; !ok {
+			errs = append(errs, fmt.Errorf("automaticGrants.criteria.ownsBadges badge %s does not exist", owned))
+		}
+	}
+
+	return errs
+}
+
+func (c BadgeConfig) validateLocales(b *Badge) []error {
+	locales := make([]string, 0, len(b.Locales))
+	for locale := range b.Locales {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+
+	var errs []error
+	for _, locale := range locales {
+		if !localePattern.MatchString(locale) {
+			errs = append(errs, fmt.Errorf("locale %q is not a lowercase locale such as de_de", locale))
+		} else if locale == c.GetDefaultLocale() {
+			errs = append(errs, fmt.Errorf("locale %s is the default locale, so its text belongs outside locales", locale))
+		}
+
+		if translation := b.Locales[locale]; translation == nil || translation.IsEmpty() {
+			errs = append(errs, fmt.Errorf("locale %s: must translate at least one text", locale))
+		}
+	}
+
+	return errs
+}
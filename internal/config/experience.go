@@ -0,0 +1,284 @@
+package config
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"mc-player-service/gen/go/grpc/experience"
+	expcurve "mc-player-service/internal/utils/experience"
+	"strings"
+	"time"
+)
+
+type ExperienceConfig struct {
+	// Events are global multipliers (e.g. a double XP weekend) applied to every grant made while they are active
+	Events []ExperienceEvent
+
+	// Caps limit how much experience can be earned for a reason within a rolling window.
+	// A reason may have multiple caps, e.g. an hourly and a daily one.
+	Caps []ExperienceCap
+
+	LevelRewards []LevelReward
+
+	// Seasons must not overlap. Season IDs are used as Mongo field names so may not contain '.' or '$'.
+	Seasons []Season
+
+	// GameModes have their own experience and level tracks that don't affect the global level.
+	// Mode IDs are used as Mongo field names so may not contain '.' or '$'.
+	GameModes []GameMode
+
+	// LevelCap the highest global level a player can reach. Once reached, they stop earning experience until
+	// they prestige, which resets their experience. 0 disables the cap and prestiging.
+	LevelCap int
+	// PrestigeRewards are granted when a player prestiges. Level rewards are only ever granted once
+	// per level, they are not granted again after prestiging.
+	PrestigeRewards []PrestigeReward
+
+	// Tiers group global levels for display, e.g. 0-9 "Stone" and 10-24 "Iron".
+	// A player is in the tier with the highest MinLevel at or below their level.
+	Tiers []LevelTier
+}
+
+type ExperienceEvent struct {
+	Name       string
+	Multiplier float64
+
+	Start time.Time
+	End   time.Time
+}
+
+// IsActive returns true if the event is running at the given time.
+// Start is inclusive, End is exclusive.
+func (e ExperienceEvent) IsActive(t time.Time) bool {
+	return !t.Before(e.Start) && t.Before(e.End)
+}
+
+type ExperienceCap struct {
+	Reason string
+	Amount int
+	Window time.Duration
+}
+
+type LevelReward struct {
+	// Level the reward is granted at. Ignored if Every is set.
+	Level int
+	// Every grants the reward at every Nth level, e.g. 10 for levels 10, 20, 30...
+	Every int
+
+	// Badges IDs of the badges granted
+	Badges []string
+}
+
+func (r LevelReward) AppliesTo(level int) bool {
+	if r.Every > 0 {
+		return level > 0 && level%r.Every == 0
+	}
+
+	return r.Level == level
+}
+
+type Season struct {
+	ID string
+
+	Start time.Time
+	End   time.Time
+}
+
+// IsActive returns true if the season is running at the given time.
+// Start is inclusive, End is exclusive.
+func (s Season) IsActive(t time.Time) bool {
+	return !t.Before(s.Start) && t.Before(s.End)
+}
+
+func (s Season) HasEnded(t time.Time) bool {
+	return !t.Before(s.End)
+}
+
+func (s Season) ToProto() *experience.Season {
+	return &experience.Season{
+		Id:    s.ID,
+		Start: timestamppb.New(s.Start),
+		End:   timestamppb.New(s.End),
+	}
+}
+
+type GameMode struct {
+	ID string
+
+	// ReasonPrefixes grants with a reason starting with any of these count towards the mode, e.g. "blocksumo:"
+	ReasonPrefixes []string
+	// Fleets grants to a player currently on one of these fleets count towards the mode if no reason prefix matched
+	Fleets []string
+
+	// Curve overrides the default level curve for the mode
+	Curve *expcurve.Curve
+}
+
+func (m GameMode) GetCurve() expcurve.Curve {
+	if m.Curve == nil {
+		return expcurve.DefaultCurve
+	}
+
+	return *m.Curve
+}
+
+type LevelTier struct {
+	// MinLevel the lowest level in the tier. The tier ends where the tier with the next highest MinLevel starts.
+	MinLevel int
+	Name     string
+	// Prefix a MiniMessage-formatted prefix, e.g. "<gray>[Stone]</gray>"
+	Prefix string
+}
+
+func (t LevelTier) ToProto() *experience.LevelTier {
+	return &experience.LevelTier{
+		Name:     t.Name,
+		Prefix:   t.Prefix,
+		MinLevel: uint32(t.MinLevel),
+	}
+}
+
+type PrestigeReward struct {
+	// Prestige the prestige the reward is granted at, starting at 1
+	Prestige int
+
+	// Badges IDs of the badges granted
+	Badges []string
+}
+
+// ActiveEvents returns all events running at the given time
+func (c ExperienceConfig) ActiveEvents(t time.Time) []ExperienceEvent {
+	var active []ExperienceEvent
+	for _, event := range c.Events {
+		if event.IsActive(t) {
+			active = append(active, event)
+		}
+	}
+
+	return active
+}
+
+// CapsForReason returns all caps that apply to the given reason
+func (c ExperienceConfig) CapsForReason(reason string) []ExperienceCap {
+	var caps []ExperienceCap
+	for _, expCap := range c.Caps {
+		if expCap.Reason == reason {
+			caps = append(caps, expCap)
+		}
+	}
+
+	return caps
+}
+
+// RewardsForLevel returns all rewards granted when a player reaches the level
+func (c ExperienceConfig) RewardsForLevel(level int) []LevelReward {
+	var rewards []LevelReward
+	for _, reward := range c.LevelRewards {
+		if reward.AppliesTo(level) {
+			rewards = append(rewards, reward)
+		}
+	}
+
+	return rewards
+}
+
+// CurrentSeason returns the season running at the given time or nil if there is none
+func (c ExperienceConfig) CurrentSeason(t time.Time) *Season {
+	for i, season := range c.Seasons {
+		if season.IsActive(t) {
+			return &c.Seasons[i]
+		}
+	}
+
+	return nil
+}
+
+func (c ExperienceConfig) GetSeason(id string) (Season, bool) {
+	for _, season := range c.Seasons {
+		if season.ID == id {
+			return season, true
+		}
+	}
+
+	return Season{}, false
+}
+
+func (c ExperienceConfig) GetGameMode(id string) (GameMode, bool) {
+	for _, mode := range c.GameModes {
+		if mode.ID == id {
+			return mode, true
+		}
+	}
+
+	return GameMode{}, false
+}
+
+// GameModeForReason returns the first mode with a reason prefix matching the reason
+func (c ExperienceConfig) GameModeForReason(reason string) (GameMode, bool) {
+	for _, mode := range c.GameModes {
+		for _, prefix := range mode.ReasonPrefixes {
+			if strings.HasPrefix(reason, prefix) {
+				return mode, true
+			}
+		}
+	}
+
+	return GameMode{}, false
+}
+
+// GameModeForFleet returns the first mode that includes the fleet
+func (c ExperienceConfig) GameModeForFleet(fleet string) (GameMode, bool) {
+	for _, mode := range c.GameModes {
+		for _, modeFleet := range mode.Fleets {
+			if modeFleet == fleet {
+				return mode, true
+			}
+		}
+	}
+
+	return GameMode{}, false
+}
+
+// HasFleetGameModes returns true if any mode is matched by fleet
+func (c ExperienceConfig) HasFleetGameModes() bool {
+	for _, mode := range c.GameModes {
+		if len(mode.Fleets) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RewardsForPrestige returns all rewards granted when a player reaches the prestige
+func (c ExperienceConfig) RewardsForPrestige(prestige int) []PrestigeReward {
+	var rewards []PrestigeReward
+	for _, reward := range c.PrestigeRewards {
+		if reward.Prestige == prestige {
+			rewards = append(rewards, reward)
+		}
+	}
+
+	return rewards
+}
+
+// LevelCapXP the experience needed to reach the level cap, or -1 if there is no cap
+func (c ExperienceConfig) LevelCapXP() int {
+	if c.LevelCap <= 0 {
+		return -1
+	}
+
+	return expcurve.LevelToXP(c.LevelCap)
+}
+
+// TierForLevel returns the tier the level falls into, or false if the level is below every tier
+func (c ExperienceConfig) TierForLevel(level int) (LevelTier, bool) {
+	var tier LevelTier
+	found := false
+	for _, t := range c.Tiers {
+		if t.MinLevel <= level && (!found || t.MinLevel > tier.MinLevel) {
+			tier = t
+			found = true
+		}
+	}
+
+	return tier, found
+}
@@ -0,0 +1,105 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Validate checks the experience config and returns all problems found joined together, or nil if the config is valid
+func (c ExperienceConfig) Validate() error {
+	var errs []error
+
+	for i, event := range c.Events {
+		// Multipliers are applied to every grant, so a negative one would take experience away
+		if event.Multiplier <= 0 {
+			errs = append(errs, fmt.Errorf("event %d (%s): multiplier must be greater than 0", i, event.Name))
+		}
+		if !event.End.After(event.Start) {
+			errs = append(errs, fmt.Errorf("event %d (%s): end must be after start", i, event.Name))
+		}
+	}
+
+	for i, expCap := range c.Caps {
+		if expCap.Window <= 0 {
+			errs = append(errs, fmt.Errorf("cap %d (%s): window must be greater than 0", i, expCap.Reason))
+		}
+		if expCap.Amount < 0 {
+			errs = append(errs, fmt.Errorf("cap %d (%s): amount must not be negative", i, expCap.Reason))
+		}
+	}
+
+	errs = append(errs, c.validateSeasons()...)
+	errs = append(errs, c.validateGameModes()...)
+
+	if c.LevelCap < 0 {
+		errs = append(errs, errors.New("levelCap must not be negative"))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c ExperienceConfig) validateSeasons() []error {
+	var errs []error
+
+	ids := make(map[string]bool, len(c.Seasons))
+	for i, season := range c.Seasons {
+		if err := validateFieldID(season.ID); err != nil {
+			errs = append(errs, fmt.Errorf("season %d: %w", i, err))
+		} else if ids[season.ID] {
+			errs = append(errs, fmt.Errorf("season %s: id is used more than once", season.ID))
+		}
+		ids[season.ID] = true
+
+		if !season.End.After(season.Start) {
+			errs = append(errs, fmt.Errorf("season %s: end must be after start", season.ID))
+		}
+	}
+
+	// Sorted by start so that each season only has to be compared with the one before it
+	seasons := slices.Clone(c.Seasons)
+	slices.SortFunc(seasons, func(a, b Season) int {
+		return a.Start.Compare(b.Start)
+	})
+	for i := 1; i < len(seasons); i++ {
+		if prev := seasons[i-1]; seasons[i].Start.Before(prev.End) {
+			errs = append(errs, fmt.Errorf("season %s: overlaps season %s", seasons[i].ID, prev.ID))
+		}
+	}
+
+	return errs
+}
+
+func (c ExperienceConfig) validateGameModes() []error {
+	var errs []error
+
+	ids := make(map[string]bool, len(c.GameModes))
+	for i, mode := range c.GameModes {
+		if err := validateFieldID(mode.ID); err != nil {
+			errs = append(errs, fmt.Errorf("game mode %d: %w", i, err))
+		} else if ids[mode.ID] {
+			errs = append(errs, fmt.Errorf("game mode %s: id is used more than once", mode.ID))
+		}
+		ids[mode.ID] = true
+
+		// The level calculation divides by B and a curve with an A of 0 would keep every player at level 0
+		if curve := mode.Curve; curve != nil && (curve.A <= 0 || curve.B <= 0) {
+			errs = append(errs, fmt.Errorf("game mode %s: curve a and b must be greater than 0", mode.ID))
+		}
+	}
+
+	return errs
+}
+
+// validateFieldID checks an ID can be used as a Mongo field name
+func validateFieldID(id string) error {
+	if strings.TrimSpace(id) == "" {
+		return errors.New("id is required")
+	}
+	if strings.ContainsAny(id, ".$") {
+		return fmt.Errorf("id %q must not contain '.' or '$'", id)
+	}
+
+	return nil
+}
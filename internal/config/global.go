@@ -1,13 +1,22 @@
 package config
 
 import (
+	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
 	"strings"
+	"time"
 )
 
 type Config struct {
-	Kafka   KafkaConfig
-	MongoDB MongoDBConfig
+	Kafka      KafkaConfig
+	MongoDB    MongoDBConfig
+	Experience ExperienceConfig
+	GitHub     GitHubConfig
+
+	BadgeCriteria  BadgeCriteriaConfig
+	BadgeCatalogue BadgeCatalogueConfig
+
+	PermissionService PermissionServiceConfig
 
 	Development bool
 
@@ -26,6 +35,46 @@ type MongoDBConfig struct {
 	URI string
 }
 
+type PermissionServiceConfig struct {
+	// Address of the permission service's gRPC server. Role badges can't be resynced if empty.
+	Address string
+	// RoleResyncInterval how often role badges are resynced. If 0 they are only resynced with the CLI.
+	RoleResyncInterval time.Duration
+}
+
+type BadgeCriteriaConfig struct {
+	// SweepInterval how often the badge criteria of every player are evaluated. Defaults to an hour.
+	SweepInterval time.Duration
+}
+
+const (
+	BadgeSourceFile  = "file"
+	BadgeSourceMongo = "mongo"
+)
+
+type BadgeCatalogueConfig struct {
+	// Source either BadgeSourceFile (the default) to read badges from the badge config file, or BadgeSourceMongo
+	// to store them in the database where they can be edited with the BadgeAdmin RPCs.
+	// Badge groups and rarityLore are always read from the badge config file.
+	Source string
+	// ReloadInterval how often badges are reloaded from the database in case a change notification was missed.
+	// Defaults to 5 minutes.
+	ReloadInterval time.Duration
+}
+
+type GitHubConfig struct {
+	// Orgs merged pull requests to repositories owned by these orgs count towards contributor badges.
+	// Contributor badges aren't synced if empty.
+	Orgs []string
+
+	// Token optional, but unauthenticated requests have a much lower rate limit
+	Token string
+	// BaseURL defaults to the public GitHub API
+	BaseURL string
+
+	SyncInterval time.Duration
+}
+
 func LoadGlobalConfig() (config Config, err error) {
 	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 	viper.AutomaticEnv()
@@ -38,10 +87,17 @@ func LoadGlobalConfig() (config Config, err error) {
 		return
 	}
 
-	err = viper.Unmarshal(&config)
+	err = viper.Unmarshal(&config, viper.DecodeHook(decodeHook))
 	if err != nil {
 		return
 	}
 
 	return
 }
+
+// decodeHook extends viper's default hooks so that timestamps may be written as RFC 3339 strings
+var decodeHook = mapstructure.ComposeDecodeHookFunc(
+	mapstructure.StringToTimeDurationHookFunc(),
+	mapstructure.StringToSliceHookFunc(","),
+	mapstructure.StringToTimeHookFunc(time.RFC3339),
+)
@@ -0,0 +1,110 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const DefaultBaseURL = "https://api.github.com"
+
+var (
+	ErrRateLimited     = errors.New("github rate limit exceeded")
+	ErrUserNotFound    = errors.New("github user not found")
+	ErrInvalidUsername = errors.New("invalid github username")
+)
+
+// usernamePattern GitHub usernames are up to 39 alphanumeric characters or single hyphens,
+// and can't start or end with a hyphen
+var usernamePattern = regexp.MustCompile(`^(?i)[a-z0-9](?:[a-z0-9]|-[a-z0-9])*$`)
+
+const maxUsernameLength = 39
+
+// IsValidUsername returns true if the username is in a format GitHub allows
+func IsValidUsername(username string) bool {
+	return len(username) <= maxUsernameLength && usernamePattern.MatchString(username)
+}
+
+// Client is a minimal GitHub REST API client. The HTTP client and base URL can be replaced,
+// e.g. to point it at a fake server.
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+	token      string
+}
+
+// NewClient creates a client using httpClient, or a default client if nil. baseURL defaults to DefaultBaseURL if empty.
+// token is optional, but unauthenticated requests have a much lower rate limit.
+func NewClient(httpClient *http.Client, baseURL string, token string) *Client {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+
+	return &Client{
+		httpClient: httpClient,
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		token:      token,
+	}
+}
+
+type searchResponse struct {
+	TotalCount int `json:"total_count"`
+}
+
+// CountMergedPullRequests returns the number of merged pull requests authored by the user
+// in repositories owned by the org
+func (c *Client) CountMergedPullRequests(ctx context.Context, username string, org string) (int, error) {
+	// The username is put into a search query, so anything else could change what is searched for
+	if !IsValidUsername(username) {
+		return 0, ErrInvalidUsername
+	}
+
+	query := url.Values{
+		"q":        {fmt.Sprintf("type:pr is:merged author:%s org:%s", username, org)},
+		"per_page": {"1"},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/search/issues?"+query.Encode(), nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+	case resp.StatusCode == http.StatusTooManyRequests,
+		resp.StatusCode == http.StatusForbidden && resp.Header.Get("X-RateLimit-Remaining") == "0":
+		return 0, ErrRateLimited
+	case resp.StatusCode == http.StatusUnprocessableEntity:
+		// GitHub rejects searches for authors that don't exist
+		return 0, ErrUserNotFound
+	default:
+		return 0, fmt.Errorf("unexpected status %s searching pull requests", resp.Status)
+	}
+
+	var result searchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return 0, fmt.Errorf("failed to decode search response: %w", err)
+	}
+
+	return result.TotalCount, nil
+}
@@ -0,0 +1,143 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return NewClient(server.Client(), server.URL+"/", "test-token")
+}
+
+func TestCountMergedPullRequestsUsesTotalCount(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/search/issues" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		query := r.URL.Query()
+		if want := "type:pr is:merged author:octocat org:emortalmc"; query.Get("q") != want {
+			t.Errorf("q = %q, want %q", query.Get("q"), want)
+		}
+		// Only the total is needed, so a single result is requested rather than paging through every result
+		if query.Get("per_page") != "1" {
+			t.Errorf("per_page = %q, want 1", query.Get("per_page"))
+		}
+		if auth := r.Header.Get("Authorization"); auth != "Bearer test-token" {
+			t.Errorf("Authorization = %q", auth)
+		}
+
+		w.Header().Set("Link", `<https://api.github.com/search/issues?page=2>; rel="next"`)
+		_, _ = w.Write([]byte(`{"total_count": 42, "incomplete_results": false, "items": [{"number": 1}]}`))
+	})
+
+	count, err := client.CountMergedPullRequests(context.Background(), "octocat", "emortalmc")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if count != 42 {
+		t.Errorf("count = %d, want 42", count)
+	}
+}
+
+func TestCountMergedPullRequestsWithoutToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if auth := r.Header.Get("Authorization"); auth != "" {
+			t.Errorf("Authorization = %q, want none", auth)
+		}
+		_, _ = w.Write([]byte(`{"total_count": 0}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.Client(), server.URL, "")
+	if _, err := client.CountMergedPullRequests(context.Background(), "octocat", "emortalmc"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestCountMergedPullRequestsErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		remaining string
+		body      string
+		wantErr   error
+	}{
+		{name: "too many requests", status: http.StatusTooManyRequests, wantErr: ErrRateLimited},
+		{name: "rate limit exhausted", status: http.StatusForbidden, remaining: "0", wantErr: ErrRateLimited},
+		{name: "forbidden", status: http.StatusForbidden, remaining: "10"},
+		{name: "unknown user", status: http.StatusUnprocessableEntity, wantErr: ErrUserNotFound},
+		{name: "server error", status: http.StatusInternalServerError},
+		{name: "invalid body", status: http.StatusOK, body: "not json"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if test.remaining != "" {
+					w.Header().Set("X-RateLimit-Remaining", test.remaining)
+				}
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(test.body))
+			})
+
+			count, err := client.CountMergedPullRequests(context.Background(), "octocat", "emortalmc")
+			if err == nil {
+				t.Fatalf("expected an error, got count %d", count)
+			}
+			if test.wantErr != nil && !errors.Is(err, test.wantErr) {
+				t.Errorf("err = %v, want %v", err, test.wantErr)
+			}
+			if test.wantErr == nil && (errors.Is(err, ErrRateLimited) || errors.Is(err, ErrUserNotFound)) {
+				t.Errorf("err = %v, want an unexpected status error", err)
+			}
+		})
+	}
+}
+
+func TestCountMergedPullRequestsRejectsInvalidUsername(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request for %s", r.URL.Query().Get("q"))
+	})
+
+	if _, err := client.CountMergedPullRequests(context.Background(), "octocat org:other", "emortalmc"); !errors.Is(err, ErrInvalidUsername) {
+		t.Errorf("err = %v, want %v", err, ErrInvalidUsername)
+	}
+}
+
+func TestIsValidUsername(t *testing.T) {
+	tests := []struct {
+		username string
+		want     bool
+	}{
+		{username: "octocat", want: true},
+		{username: "Octo-Cat", want: true},
+		{username: "a", want: true},
+		{username: "a1-b2-c3", want: true},
+		{username: strings.Repeat("a", 39), want: true},
+		{username: strings.Repeat("a", 40)},
+		{username: strings.Repeat("a-", 19) + "a", want: true},
+		{username: strings.Repeat("a-", 20) + "a"},
+		{username: ""},
+		{username: "-octocat"},
+		{username: "octocat-"},
+		{username: "octo--cat"},
+		{username: "octo_cat"},
+		{username: "octocat org:other"},
+	}
+
+	for _, test := range tests {
+		if got := IsValidUsername(test.username); got != test.want {
+			t.Errorf("IsValidUsername(%q) = %t, want %t", test.username, got, test.want)
+		}
+	}
+}
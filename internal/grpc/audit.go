@@ -0,0 +1,28 @@
+package grpc
+
+import (
+	"context"
+	"google.golang.org/grpc/metadata"
+	"mc-player-service/internal/app/badge"
+)
+
+const (
+	actorMetadataKey  = "actor"
+	reasonMetadataKey = "reason"
+)
+
+// withBadgeAudit records badge changes made by the request against the actor and reason in its metadata
+func withBadgeAudit(ctx context.Context) context.Context {
+	audit := badge.Audit{Source: "grpc"}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(actorMetadataKey); len(values) > 0 {
+			audit.Actor = values[0]
+		}
+		if values := md.Get(reasonMetadataKey); len(values) > 0 {
+			audit.Reason = values[0]
+		}
+	}
+
+	return badge.WithAudit(ctx, audit)
+}
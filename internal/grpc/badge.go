@@ -12,6 +12,9 @@ import (
 	"mc-player-service/internal/app/badge"
 	"mc-player-service/internal/config"
 	"mc-player-service/internal/repository"
+	"slices"
+	"strconv"
+	"strings"
 )
 
 type badgeService struct {
@@ -19,10 +22,10 @@ type badgeService struct {
 
 	repo     repository.BadgeReader
 	badgeSvc badge.Service
-	badgeCfg config.BadgeConfig
+	badgeCfg *config.BadgeConfigHolder
 }
 
-func newBadgeService(repo repository.Repository, badgeSvc badge.Service, badgeCfg config.BadgeConfig) pb.BadgeManagerServer {
+func newBadgeService(repo repository.Repository, badgeSvc badge.Service, badgeCfg *config.BadgeConfigHolder) pb.BadgeManagerServer {
 	return &badgeService{
 		repo:     repo,
 		badgeSvc: badgeSvc,
@@ -39,10 +42,13 @@ func (s *badgeService) SetActivePlayerBadge(ctx context.Context, request *pb.Set
 		return nil, status.Error(codes.InvalidArgument, "invalid player_id")
 	}
 
-	if err := s.badgeSvc.SetActiveBadge(ctx, playerId, request.BadgeId); err != nil {
-		if errors.Is(err, badge.DoesntHaveBadgeErr) {
+	if err := s.badgeSvc.SetActiveBadge(withBadgeAudit(ctx), playerId, request.BadgeId); err != nil {
+		switch {
+		case errors.Is(err, badge.DoesntHaveBadgeErr):
 			return nil, setActivePlayerBadgeDoesntHaveBadgeErr
-		} else {
+		case errors.Is(err, badge.ErrForcedBadgeOwned):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		default:
 			return nil, status.Error(codes.Internal, "failed to set active badge: "+err.Error())
 		}
 	}
@@ -59,7 +65,7 @@ func (s *badgeService) RemoveBadgeFromPlayer(ctx context.Context, request *pb.Re
 		return nil, status.Error(codes.InvalidArgument, "invalid player_id")
 	}
 
-	err = s.badgeSvc.RemoveBadgeFromPlayer(ctx, playerId, request.BadgeId)
+	err = s.badgeSvc.RemoveBadgeFromPlayer(withBadgeAudit(ctx), playerId, request.BadgeId)
 	if err != nil {
 		if errors.Is(err, badge.DoesntHaveBadgeErr) {
 			return nil, removeBadgeFromPlayerDoesntHaveBadgeErr
@@ -85,16 +91,20 @@ func (s *badgeService) GetActivePlayerBadge(ctx context.Context, request *pb.Get
 		return nil, status.Error(codes.NotFound, "player does not have any badge")
 	}
 
-	badge, ok := s.badgeCfg.Badges[*badgeId]
+	badgeCfg := s.badgeCfg.Get()
+	badge, ok := badgeCfg.Badges[*badgeId]
 	if !ok {
-		return nil, fmt.Errorf("failed to resolve badgeId to config")
+		// The badge has been archived, so it can no longer be shown
+		return nil, status.Error(codes.NotFound, "player does not have any badge")
 	}
 
 	return &pb.GetActivePlayerBadgeResponse{
-		Badge: badge.ToProto(),
+		Badge: badgeCfg.Localize(badge, localeFromContext(ctx)).ToProto(),
 	}, nil
 }
 
+// GetPlayerBadges doesn't include when badges expire as the response is defined in proto-specs.
+// Expiry times are returned by BadgeAdmin#GetPlayerBadgeGrants instead.
 func (s *badgeService) GetPlayerBadges(ctx context.Context, request *pb.GetPlayerBadgesRequest) (*pb.GetPlayerBadgesResponse, error) {
 	playerId, err := uuid.Parse(request.PlayerId)
 	if err != nil {
@@ -106,19 +116,29 @@ func (s *badgeService) GetPlayerBadges(ctx context.Context, request *pb.GetPlaye
 		return nil, fmt.Errorf("failed to get player: %w", err)
 	}
 
-	badges := make([]*pbmodel.Badge, len(player.BadgeIDs))
-	for i, badgeId := range player.BadgeIDs {
-		b, ok := s.badgeCfg.Badges[badgeId]
+	badgeCfg := s.badgeCfg.Get()
+	locale := localeFromContext(ctx)
+	badges := make([]*pbmodel.Badge, 0, len(player.BadgeIDs))
+	for _, badgeId := range player.BadgeIDs {
+		// Archived badges are kept by players but can no longer be shown
+		b, ok := badgeCfg.Badges[badgeId]
 		if !ok {
-			return nil, fmt.Errorf("failed to resolve badgeId to config")
+			continue
 		}
 
-		badges[i] = b.ToProto()
+		badges = append(badges, badgeCfg.Localize(b, locale).ToProto())
+	}
+
+	activeBadgeId := player.ActiveBadge
+	if activeBadgeId != nil {
+		if _, ok := badgeCfg.Badges[*activeBadgeId]; !ok {
+			activeBadgeId = nil
+		}
 	}
 
 	return &pb.GetPlayerBadgesResponse{
 		Badges:        badges,
-		ActiveBadgeId: player.ActiveBadge,
+		ActiveBadgeId: activeBadgeId,
 	}, nil
 }
 
@@ -131,7 +151,7 @@ func (s *badgeService) AddBadgeToPlayer(ctx context.Context, request *pb.AddBadg
 		return nil, status.Error(codes.InvalidArgument, "invalid player_id")
 	}
 
-	err = s.badgeSvc.AddBadgeToPlayer(ctx, playerId, request.BadgeId)
+	err = s.badgeSvc.AddBadgeToPlayer(withBadgeAudit(ctx), playerId, request.BadgeId)
 	if err != nil {
 		if errors.Is(err, badge.AlreadyHasBadgeErr) {
 			return nil, addBadgeToPlayerAlreadyHasBadgeErr
@@ -145,10 +165,32 @@ func (s *badgeService) AddBadgeToPlayer(ctx context.Context, request *pb.AddBadg
 	return &pb.AddBadgeToPlayerResponse{}, nil
 }
 
-func (s *badgeService) GetBadges(context.Context, *pb.GetBadgesRequest) (*pb.GetBadgesResponse, error) {
-	badges := make([]*pbmodel.Badge, 0, len(s.badgeCfg.Badges))
-	for _, badge := range s.badgeCfg.Badges {
-		badges = append(badges, badge.ToProto())
+func (s *badgeService) GetBadges(ctx context.Context, _ *pb.GetBadgesRequest) (*pb.GetBadgesResponse, error) {
+	badgeCfg := s.badgeCfg.Get()
+
+	var stats *badge.Stats
+	if badgeCfg.RarityLore != "" {
+		// The badges are still returned without rarity if the stats can't be computed
+		if result, err := s.badgeSvc.GetBadgeStats(ctx); err == nil {
+			stats = &result
+		}
+	}
+
+	locale := localeFromContext(ctx)
+	badges := make([]*pbmodel.Badge, 0, len(badgeCfg.Badges))
+	for _, b := range badgeCfg.Badges {
+		protoBadge := badgeCfg.Localize(b, locale).ToProto()
+		if stats != nil {
+			line := strings.NewReplacer(
+				"{owners}", strconv.FormatInt(stats.Owners[b.Id], 10),
+				"{percentage}", strconv.FormatFloat(stats.Rarity(b.Id), 'f', 1, 64),
+			).Replace(badgeCfg.RarityLore)
+
+			// Clipped so the config's lore slice is never appended to
+			protoBadge.GuiItem.Lore = append(slices.Clip(protoBadge.GuiItem.Lore), line)
+		}
+
+		badges = append(badges, protoBadge)
 	}
 
 	return &pb.GetBadgesResponse{
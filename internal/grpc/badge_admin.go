@@ -0,0 +1,214 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/emortalmc/proto-specs/gen/go/model/common"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	pb "mc-player-service/gen/go/grpc/badgeadmin"
+	"mc-player-service/internal/app/badge"
+	"mc-player-service/internal/app/badgejob"
+	"mc-player-service/internal/app/catalogue"
+	"mc-player-service/internal/github"
+	"mc-player-service/internal/repository"
+	"mc-player-service/internal/utils"
+	"strings"
+)
+
+type badgeAdminService struct {
+	pb.UnimplementedBadgeAdminServer
+
+	repo     repository.Repository
+	badgeSvc badge.Service
+	// catalogueSvc is nil if badges are read from the badge config file
+	catalogueSvc catalogue.Service
+	jobSvc       badgejob.Service
+}
+
+func newBadgeAdminService(repo repository.Repository, badgeSvc badge.Service, catalogueSvc catalogue.Service,
+	jobSvc badgejob.Service) pb.BadgeAdminServer {
+
+	return &badgeAdminService{
+		repo:         repo,
+		badgeSvc:     badgeSvc,
+		catalogueSvc: catalogueSvc,
+		jobSvc:       jobSvc,
+	}
+}
+
+func (s *badgeAdminService) SetPlayerGitHubAccount(ctx context.Context, req *pb.SetPlayerGitHubAccountRequest) (*pb.SetPlayerGitHubAccountResponse, error) {
+	pID, err := uuid.Parse(req.PlayerId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid player id %s", req.PlayerId))
+	}
+
+	var username *string
+	if req.GithubUsername != nil {
+		// GitHub usernames are case-insensitive
+		lower := strings.ToLower(strings.TrimSpace(*req.GithubUsername))
+		if !github.IsValidUsername(lower) {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid github username %s", *req.GithubUsername))
+		}
+		username = &lower
+	}
+
+	if err := s.repo.SetPlayerGitHubUsername(ctx, pID, username); err != nil {
+		switch {
+		case errors.Is(err, mongo.ErrNoDocuments):
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("player with id %s not found", req.PlayerId))
+		case mongo.IsDuplicateKeyError(err):
+			return nil, status.Error(codes.AlreadyExists, "github account is linked to another player")
+		default:
+			return nil, status.Error(codes.Internal, "failed to set github account")
+		}
+	}
+
+	return &pb.SetPlayerGitHubAccountResponse{}, nil
+}
+
+func (s *badgeAdminService) AddTemporaryBadgeToPlayer(ctx context.Context, req *pb.AddTemporaryBadgeToPlayerRequest) (*pb.AddTemporaryBadgeToPlayerResponse, error) {
+	pID, err := uuid.Parse(req.PlayerId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid player id %s", req.PlayerId))
+	}
+
+	if req.ExpiresAt == nil {
+		return nil, status.Error(codes.InvalidArgument, "expires_at is required")
+	}
+
+	if err := s.badgeSvc.AddTemporaryBadgeToPlayer(withBadgeAudit(ctx), pID, req.BadgeId, req.ExpiresAt.AsTime()); err != nil {
+		switch {
+		case errors.Is(err, badge.ErrExpiryInPast), errors.Is(err, badge.DoesntExistErr):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, badge.AlreadyHasBadgeErr):
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "failed to add badge to player")
+		}
+	}
+
+	return &pb.AddTemporaryBadgeToPlayerResponse{}, nil
+}
+
+func (s *badgeAdminService) GetPlayerBadgeGrants(ctx context.Context, req *pb.GetPlayerBadgeGrantsRequest) (*pb.GetPlayerBadgeGrantsResponse, error) {
+	pID, err := uuid.Parse(req.PlayerId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid player id %s", req.PlayerId))
+	}
+
+	grants, err := s.badgeSvc.GetPlayerBadgeGrants(ctx, pID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("player with id %s not found", req.PlayerId))
+		}
+		return nil, status.Error(codes.Internal, "failed to get player badge grants")
+	}
+
+	protoGrants := make([]*pb.BadgeGrant, len(grants))
+	for i, grant := range grants {
+		protoGrants[i] = grant.ToProto()
+	}
+
+	return &pb.GetPlayerBadgeGrantsResponse{
+		Grants: protoGrants,
+	}, nil
+}
+
+func (s *badgeAdminService) ResetActivePlayerBadge(ctx context.Context, req *pb.ResetActivePlayerBadgeRequest) (*pb.ResetActivePlayerBadgeResponse, error) {
+	pID, err := uuid.Parse(req.PlayerId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid player id %s", req.PlayerId))
+	}
+
+	if err := s.badgeSvc.ResetActiveBadge(withBadgeAudit(ctx), pID); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("player with id %s not found", req.PlayerId))
+		}
+		return nil, status.Error(codes.Internal, "failed to reset active badge")
+	}
+
+	return &pb.ResetActivePlayerBadgeResponse{}, nil
+}
+
+func (s *badgeAdminService) GetBadgeAuditRecords(ctx context.Context, req *pb.GetBadgeAuditRecordsRequest) (*pb.GetBadgeAuditRecordsResponse, error) {
+	if req.PlayerId == nil && req.BadgeId == nil {
+		return nil, status.Error(codes.InvalidArgument, "player_id or badge_id is required")
+	}
+
+	var playerID *uuid.UUID
+	if req.PlayerId != nil {
+		pID, err := uuid.Parse(*req.PlayerId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid player id %s", *req.PlayerId))
+		}
+		playerID = &pID
+	}
+
+	if req.Pageable == nil {
+		req.Pageable = &common.Pageable{
+			Page: 1,
+			Size: utils.PointerOf(uint64(20)),
+		}
+	} else if req.Pageable.Size == nil || *req.Pageable.Size == 0 {
+		req.Pageable.Size = utils.PointerOf(uint64(20))
+	}
+	if req.Pageable.Page == 0 {
+		req.Pageable.Page = 1
+	}
+
+	records, pageData, err := s.badgeSvc.GetAuditRecords(ctx, playerID, req.BadgeId, req.Pageable)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get badge audit records")
+	}
+
+	protoRecords := make([]*pb.BadgeAuditRecord, len(records))
+	for i, record := range records {
+		protoRecords[i] = record.ToProto()
+	}
+
+	return &pb.GetBadgeAuditRecordsResponse{
+		Records:  protoRecords,
+		PageData: pageData,
+	}, nil
+}
+
+func (s *badgeAdminService) GetBadgeStats(ctx context.Context, _ *pb.GetBadgeStatsRequest) (*pb.GetBadgeStatsResponse, error) {
+	stats, err := s.badgeSvc.GetBadgeStats(ctx)
+	if err != nil {
+		if errors.Is(err, badge.ErrStatsNotReady) {
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to get badge stats")
+	}
+
+	badges := make([]*pb.BadgeStats, 0, len(stats.Owners))
+	for badgeID, owners := range stats.Owners {
+		badges = append(badges, &pb.BadgeStats{
+			BadgeId: badgeID,
+			Owners:  uint64(owners),
+			Rarity:  stats.Rarity(badgeID),
+		})
+	}
+
+	return &pb.GetBadgeStatsResponse{
+		Badges:       badges,
+		TotalPlayers: uint64(stats.TotalPlayers),
+		ComputedAt:   timestamppb.New(stats.ComputedAt),
+	}, nil
+}
+
+func (s *badgeAdminService) GetBadgeGroups(_ context.Context, _ *pb.GetBadgeGroupsRequest) (*pb.GetBadgeGroupsResponse, error) {
+	groups := s.badgeSvc.GetBadgeGroups()
+
+	protoGroups := make([]*pb.BadgeGroup, len(groups))
+	for i, group := range groups {
+		protoGroups[i] = group.ToProto()
+	}
+
+	return &pb.GetBadgeGroupsResponse{Groups: protoGroups}, nil
+}
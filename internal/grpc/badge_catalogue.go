@@ -0,0 +1,103 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	pb "mc-player-service/gen/go/grpc/badgeadmin"
+	"mc-player-service/internal/app/catalogue"
+	"mc-player-service/internal/config"
+)
+
+var catalogueDisabledErr = status.Error(codes.FailedPrecondition, "badges are read from the badge config file, not the database")
+
+func (s *badgeAdminService) GetBadgeDefinitions(ctx context.Context, req *pb.GetBadgeDefinitionsRequest) (*pb.GetBadgeDefinitionsResponse, error) {
+	if s.catalogueSvc == nil {
+		return nil, catalogueDisabledErr
+	}
+
+	badges, err := s.catalogueSvc.GetBadges(ctx, req.IncludeArchived)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get badges")
+	}
+
+	protoBadges := make([]*pb.BadgeDefinition, len(badges))
+	for i, b := range badges {
+		protoBadges[i] = b.ToProto()
+	}
+
+	return &pb.GetBadgeDefinitionsResponse{Badges: protoBadges}, nil
+}
+
+func (s *badgeAdminService) CreateBadge(ctx context.Context, req *pb.CreateBadgeRequest) (*pb.CreateBadgeResponse, error) {
+	if s.catalogueSvc == nil {
+		return nil, catalogueDisabledErr
+	}
+	if req.Badge == nil {
+		return nil, status.Error(codes.InvalidArgument, "badge is required")
+	}
+
+	if err := s.catalogueSvc.CreateBadge(ctx, config.BadgeFromDefinitionProto(req.Badge)); err != nil {
+		return nil, catalogueErrorToStatus(err, "failed to create badge")
+	}
+
+	return &pb.CreateBadgeResponse{}, nil
+}
+
+func (s *badgeAdminService) UpdateBadge(ctx context.Context, req *pb.UpdateBadgeRequest) (*pb.UpdateBadgeResponse, error) {
+	if s.catalogueSvc == nil {
+		return nil, catalogueDisabledErr
+	}
+	if req.Badge == nil {
+		return nil, status.Error(codes.InvalidArgument, "badge is required")
+	}
+
+	if err := s.catalogueSvc.UpdateBadge(ctx, config.BadgeFromDefinitionProto(req.Badge)); err != nil {
+		return nil, catalogueErrorToStatus(err, "failed to update badge")
+	}
+
+	return &pb.UpdateBadgeResponse{}, nil
+}
+
+func (s *badgeAdminService) SetBadgeArchived(ctx context.Context, req *pb.SetBadgeArchivedRequest) (*pb.SetBadgeArchivedResponse, error) {
+	if s.catalogueSvc == nil {
+		return nil, catalogueDisabledErr
+	}
+
+	if err := s.catalogueSvc.SetBadgeArchived(ctx, req.BadgeId, req.Archived); err != nil {
+		return nil, catalogueErrorToStatus(err, "failed to set badge archived")
+	}
+
+	return &pb.SetBadgeArchivedResponse{}, nil
+}
+
+func (s *badgeAdminService) ReorderBadges(ctx context.Context, req *pb.ReorderBadgesRequest) (*pb.ReorderBadgesResponse, error) {
+	if s.catalogueSvc == nil {
+		return nil, catalogueDisabledErr
+	}
+	if len(req.BadgeIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "badge_ids is required")
+	}
+
+	if err := s.catalogueSvc.ReorderBadges(ctx, req.BadgeIds); err != nil {
+		return nil, catalogueErrorToStatus(err, "failed to reorder badges")
+	}
+
+	return &pb.ReorderBadgesResponse{}, nil
+}
+
+func catalogueErrorToStatus(err error, internalMsg string) error {
+	switch {
+	case errors.Is(err, catalogue.ErrInvalidBadge):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, catalogue.ErrBadgeNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, catalogue.ErrBadgeExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, catalogue.ErrConcurrentChange):
+		return status.Error(codes.Aborted, err.Error())
+	default:
+		return status.Error(codes.Internal, internalMsg)
+	}
+}
@@ -0,0 +1,120 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/emortalmc/proto-specs/gen/go/model/common"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	pb "mc-player-service/gen/go/grpc/badgeadmin"
+	"mc-player-service/internal/app/badgejob"
+	"mc-player-service/internal/repository/model"
+	"mc-player-service/internal/utils"
+)
+
+func (s *badgeAdminService) CreateBadgeJob(ctx context.Context, req *pb.CreateBadgeJobRequest) (*pb.CreateBadgeJobResponse, error) {
+	jobReq := badgejob.JobRequest{
+		Action:  req.Action,
+		BadgeID: req.BadgeId,
+	}
+
+	if req.ExpiresAt != nil {
+		expiresAt := req.ExpiresAt.AsTime()
+		jobReq.ExpiresAt = &expiresAt
+	}
+
+	switch players := req.Players.(type) {
+	case *pb.CreateBadgeJobRequest_PlayerList:
+		jobReq.PlayerIDs = make([]uuid.UUID, len(players.PlayerList.PlayerIds))
+		for i, id := range players.PlayerList.PlayerIds {
+			pID, err := uuid.Parse(id)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid player id %s", id))
+			}
+			jobReq.PlayerIDs[i] = pID
+		}
+	case *pb.CreateBadgeJobRequest_PlayerFilter:
+		jobReq.PlayerFilter = model.BadgeJobFilterFromProto(players.PlayerFilter)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "player_list or player_filter is required")
+	}
+
+	job, err := s.jobSvc.CreateJob(withBadgeAudit(ctx), jobReq)
+	if err != nil {
+		return nil, badgeJobErrorToStatus(err, "failed to create badge job")
+	}
+
+	return &pb.CreateBadgeJobResponse{Job: job.ToProto()}, nil
+}
+
+func (s *badgeAdminService) GetBadgeJob(ctx context.Context, req *pb.GetBadgeJobRequest) (*pb.GetBadgeJobResponse, error) {
+	jobID, err := primitive.ObjectIDFromHex(req.JobId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid job id %s", req.JobId))
+	}
+
+	job, err := s.jobSvc.GetJob(ctx, jobID)
+	if err != nil {
+		return nil, badgeJobErrorToStatus(err, "failed to get badge job")
+	}
+
+	return &pb.GetBadgeJobResponse{Job: job.ToProto()}, nil
+}
+
+func (s *badgeAdminService) GetBadgeJobs(ctx context.Context, req *pb.GetBadgeJobsRequest) (*pb.GetBadgeJobsResponse, error) {
+	if req.Pageable == nil {
+		req.Pageable = &common.Pageable{
+			Page: 1,
+			Size: utils.PointerOf(uint64(20)),
+		}
+	} else if req.Pageable.Size == nil || *req.Pageable.Size == 0 {
+		req.Pageable.Size = utils.PointerOf(uint64(20))
+	}
+	if req.Pageable.Page == 0 {
+		req.Pageable.Page = 1
+	}
+
+	jobs, pageData, err := s.jobSvc.GetJobs(ctx, req.Pageable)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get badge jobs")
+	}
+
+	protoJobs := make([]*pb.BadgeJob, len(jobs))
+	for i, job := range jobs {
+		protoJobs[i] = job.ToProto()
+	}
+
+	return &pb.GetBadgeJobsResponse{
+		Jobs:     protoJobs,
+		PageData: pageData,
+	}, nil
+}
+
+func (s *badgeAdminService) CancelBadgeJob(ctx context.Context, req *pb.CancelBadgeJobRequest) (*pb.CancelBadgeJobResponse, error) {
+	jobID, err := primitive.ObjectIDFromHex(req.JobId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid job id %s", req.JobId))
+	}
+
+	if err := s.jobSvc.CancelJob(ctx, jobID); err != nil {
+		return nil, badgeJobErrorToStatus(err, "failed to cancel badge job")
+	}
+
+	return &pb.CancelBadgeJobResponse{}, nil
+}
+
+func badgeJobErrorToStatus(err error, internalMsg string) error {
+	switch {
+	case errors.Is(err, badgejob.ErrInvalidJob):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, badgejob.ErrJobNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, badgejob.ErrJobFinished):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, internalMsg)
+	}
+}
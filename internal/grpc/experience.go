@@ -0,0 +1,346 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	pb "mc-player-service/gen/go/grpc/experience"
+	"mc-player-service/internal/app/player"
+	"time"
+)
+
+// maxRevocationWindow limits how many transactions a single RevokeExperienceByReason call loads
+const maxRevocationWindow = 31 * 24 * time.Hour
+
+type experienceService struct {
+	pb.UnimplementedExperienceManagerServer
+
+	svc player.Service
+}
+
+func newExperienceService(svc player.Service) pb.ExperienceManagerServer {
+	return &experienceService{
+		svc: svc,
+	}
+}
+
+func (s *experienceService) GrantExperience(ctx context.Context, req *pb.GrantExperienceRequest) (*pb.GrantExperienceResponse, error) {
+	ids := make([]uuid.UUID, len(req.PlayerIds))
+	for i, id := range req.PlayerIds {
+		pID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid player id %s", id))
+		}
+
+		ids[i] = pID
+	}
+
+	results := make(map[string]*pb.ExperienceGrantResult, len(ids))
+	for _, id := range ids {
+		result, err := s.svc.AddExperienceByID(ctx, id, req.Reason, int(req.Experience))
+		if err != nil {
+			if errors.Is(err, player.ErrNegativeExperience) {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+			return nil, fmt.Errorf("error adding experience to player %s: %w", id.String(), err)
+		}
+
+		protoResult := &pb.ExperienceGrantResult{
+			Requested:       uint64(result.Requested),
+			Granted:         uint64(result.Granted),
+			CapReached:      result.CapReached,
+			NewExperience:   uint64(result.NewExperience),
+			LevelCapReached: result.LevelCapReached,
+			Prestige:        uint32(result.Prestige),
+		}
+		if !result.TransactionID.IsZero() {
+			protoResult.TransactionId = result.TransactionID.Hex()
+		}
+		if result.Tier != nil {
+			protoResult.Tier = result.Tier.ToProto()
+		}
+
+		results[id.String()] = protoResult
+	}
+
+	return &pb.GrantExperienceResponse{
+		Results: results,
+	}, nil
+}
+
+func (s *experienceService) GetPlayerLevel(ctx context.Context, req *pb.GetPlayerLevelRequest) (*pb.GetPlayerLevelResponse, error) {
+	pID, err := uuid.Parse(req.PlayerId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid player id %s", req.PlayerId))
+	}
+
+	progress, err := s.svc.GetPlayerLevel(ctx, pID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("player with id %s not found", req.PlayerId))
+		}
+		return nil, status.Error(codes.Internal, "failed to get player level")
+	}
+
+	resp := &pb.GetPlayerLevelResponse{
+		Experience:  uint64(progress.Experience),
+		Level:       uint32(progress.Level),
+		Prestige:    uint32(progress.Prestige),
+		CanPrestige: progress.CanPrestige,
+	}
+	if progress.Tier != nil {
+		resp.Tier = progress.Tier.ToProto()
+	}
+
+	return resp, nil
+}
+
+func (s *experienceService) GetLevelTiers(_ context.Context, _ *pb.GetLevelTiersRequest) (*pb.GetLevelTiersResponse, error) {
+	tiers := s.svc.GetLevelTiers()
+
+	protoTiers := make([]*pb.LevelTier, len(tiers))
+	for i, tier := range tiers {
+		protoTiers[i] = tier.ToProto()
+	}
+
+	return &pb.GetLevelTiersResponse{
+		Tiers: protoTiers,
+	}, nil
+}
+
+func (s *experienceService) Prestige(ctx context.Context, req *pb.PrestigeRequest) (*pb.PrestigeResponse, error) {
+	pID, err := uuid.Parse(req.PlayerId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid player id %s", req.PlayerId))
+	}
+
+	newPrestige, err := s.svc.Prestige(ctx, pID)
+	if err != nil {
+		switch {
+		case errors.Is(err, mongo.ErrNoDocuments):
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("player with id %s not found", req.PlayerId))
+		case errors.Is(err, player.ErrPrestigeDisabled), errors.Is(err, player.ErrLevelCapNotReached):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, player.ErrExperienceChanged):
+			return nil, status.Error(codes.Aborted, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "failed to prestige player")
+		}
+	}
+
+	return &pb.PrestigeResponse{
+		NewPrestige: uint32(newPrestige),
+	}, nil
+}
+
+func (s *experienceService) RevokeExperienceTransaction(ctx context.Context, req *pb.RevokeExperienceTransactionRequest) (*pb.RevocationResponse, error) {
+	transactionID, err := primitive.ObjectIDFromHex(req.TransactionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid transaction id %s", req.TransactionId))
+	}
+
+	result, err := s.svc.RevokeExperienceTransaction(ctx, transactionID, req.Reason)
+	if err != nil {
+		switch {
+		case errors.Is(err, player.ErrTransactionNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, player.ErrTransactionAlreadyRevoked):
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		case errors.Is(err, player.ErrTransactionNotRevocable):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "failed to revoke transaction")
+		}
+	}
+
+	return revocationResultToProto(result), nil
+}
+
+func (s *experienceService) RevokeExperienceByReason(ctx context.Context, req *pb.RevokeExperienceByReasonRequest) (*pb.RevocationResponse, error) {
+	if req.TransactionReason == "" {
+		return nil, status.Error(codes.InvalidArgument, "transaction_reason is required")
+	}
+	if req.From == nil || req.To == nil {
+		return nil, status.Error(codes.InvalidArgument, "from and to are required")
+	}
+
+	from, to := req.From.AsTime(), req.To.AsTime()
+	if !from.Before(to) {
+		return nil, status.Error(codes.InvalidArgument, "from must be before to")
+	}
+	if to.Sub(from) > maxRevocationWindow {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("from and to must be at most %s apart", maxRevocationWindow))
+	}
+
+	result, err := s.svc.RevokeExperienceByReason(ctx, req.TransactionReason, from, to, req.Reason)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to revoke experience")
+	}
+
+	return revocationResultToProto(result), nil
+}
+
+func revocationResultToProto(result player.RevocationResult) *pb.RevocationResponse {
+	return &pb.RevocationResponse{
+		TransactionsRevoked: uint32(result.TransactionsRevoked),
+		PlayersAffected:     uint32(result.PlayersAffected),
+		ExperienceRevoked:   uint64(result.ExperienceRevoked),
+	}
+}
+
+func (s *experienceService) GetPlayerSeasons(ctx context.Context, req *pb.GetPlayerSeasonsRequest) (*pb.GetPlayerSeasonsResponse, error) {
+	pID, err := uuid.Parse(req.PlayerId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid player id %s", req.PlayerId))
+	}
+
+	current, past, err := s.svc.GetPlayerSeasons(ctx, pID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("player with id %s not found", req.PlayerId))
+		}
+		return nil, status.Error(codes.Internal, "failed to get player seasons")
+	}
+
+	resp := &pb.GetPlayerSeasonsResponse{
+		PastSeasons: make([]*pb.SeasonProgress, len(past)),
+	}
+	if current != nil {
+		resp.CurrentSeason = seasonProgressToProto(*current)
+	}
+	for i, progress := range past {
+		resp.PastSeasons[i] = seasonProgressToProto(progress)
+	}
+
+	return resp, nil
+}
+
+func (s *experienceService) GetSeasonLeaderboard(ctx context.Context, req *pb.GetSeasonLeaderboardRequest) (*pb.GetSeasonLeaderboardResponse, error) {
+	limit := 10
+	if req.Limit != nil && *req.Limit > 0 {
+		limit = int(*req.Limit)
+	}
+
+	season, results, err := s.svc.GetSeasonLeaderboard(ctx, req.GetSeasonId(), limit)
+	if err != nil {
+		if errors.Is(err, player.ErrSeasonNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to get season leaderboard")
+	}
+
+	entries := make([]*pb.SeasonLeaderboardEntry, len(results))
+	for i, result := range results {
+		entries[i] = &pb.SeasonLeaderboardEntry{
+			PlayerId:   result.PlayerID.String(),
+			Experience: uint64(result.Experience),
+			Level:      uint32(result.Level),
+		}
+	}
+
+	return &pb.GetSeasonLeaderboardResponse{
+		Season:  season.ToProto(),
+		Entries: entries,
+	}, nil
+}
+
+func seasonProgressToProto(progress player.SeasonProgress) *pb.SeasonProgress {
+	return &pb.SeasonProgress{
+		Season:     progress.Season.ToProto(),
+		Experience: uint64(progress.Experience),
+		Level:      uint32(progress.Level),
+	}
+}
+
+func (s *experienceService) GetPlayerGameModes(ctx context.Context, req *pb.GetPlayerGameModesRequest) (*pb.GetPlayerGameModesResponse, error) {
+	pID, err := uuid.Parse(req.PlayerId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid player id %s", req.PlayerId))
+	}
+
+	progress, err := s.svc.GetPlayerGameModes(ctx, pID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("player with id %s not found", req.PlayerId))
+		}
+		return nil, status.Error(codes.Internal, "failed to get player game modes")
+	}
+
+	gameModes := make([]*pb.GameModeProgress, len(progress))
+	for i, p := range progress {
+		gameModes[i] = &pb.GameModeProgress{
+			GameModeId: p.GameMode.ID,
+			Experience: uint64(p.Experience),
+			Level:      uint32(p.Level),
+		}
+	}
+
+	return &pb.GetPlayerGameModesResponse{
+		GameModes: gameModes,
+	}, nil
+}
+
+func (s *experienceService) AddExperienceBooster(ctx context.Context, req *pb.AddExperienceBoosterRequest) (*pb.AddExperienceBoosterResponse, error) {
+	pID, err := uuid.Parse(req.PlayerId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid player id %s", req.PlayerId))
+	}
+
+	if req.ExpiresAt == nil {
+		return nil, status.Error(codes.InvalidArgument, "expires_at is required")
+	}
+
+	booster, err := s.svc.AddExperienceBooster(ctx, pID, req.Multiplier, req.Source, req.ExpiresAt.AsTime())
+	if err != nil {
+		if errors.Is(err, player.ErrInvalidMultiplier) || errors.Is(err, player.ErrBoosterExpired) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to add booster")
+	}
+
+	return &pb.AddExperienceBoosterResponse{
+		Booster: booster.ToProto(),
+	}, nil
+}
+
+func (s *experienceService) GetExperienceBoosters(ctx context.Context, req *pb.GetExperienceBoostersRequest) (*pb.GetExperienceBoostersResponse, error) {
+	pID, err := uuid.Parse(req.PlayerId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid player id %s", req.PlayerId))
+	}
+
+	boosters, err := s.svc.GetExperienceBoosters(ctx, pID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get boosters")
+	}
+
+	protoBoosters := make([]*pb.ExperienceBooster, len(boosters))
+	for i, booster := range boosters {
+		protoBoosters[i] = booster.ToProto()
+	}
+
+	return &pb.GetExperienceBoostersResponse{
+		Boosters: protoBoosters,
+	}, nil
+}
+
+func (s *experienceService) RemoveExperienceBooster(ctx context.Context, req *pb.RemoveExperienceBoosterRequest) (*pb.RemoveExperienceBoosterResponse, error) {
+	boosterID, err := primitive.ObjectIDFromHex(req.BoosterId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid booster id %s", req.BoosterId))
+	}
+
+	if err := s.svc.RemoveExperienceBooster(ctx, boosterID); err != nil {
+		if errors.Is(err, player.ErrBoosterNotFound) {
+			return nil, status.Error(codes.NotFound, "booster does not exist")
+		}
+		return nil, status.Error(codes.Internal, "failed to remove booster")
+	}
+
+	return &pb.RemoveExperienceBoosterResponse{}, nil
+}
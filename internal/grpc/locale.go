@@ -0,0 +1,20 @@
+package grpc
+
+import (
+	"context"
+	"google.golang.org/grpc/metadata"
+)
+
+// localeMetadataKey the locale badge text is returned in, e.g. "de_de". The shared badge protos have no locale field.
+const localeMetadataKey = "locale"
+
+// localeFromContext returns the locale in the request metadata, or an empty string for the default locale
+func localeFromContext(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(localeMetadataKey); len(values) > 0 {
+			return values[0]
+		}
+	}
+
+	return ""
+}
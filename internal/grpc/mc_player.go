@@ -194,13 +194,13 @@ func (s *mcPlayerService) AddExperienceToPlayers(ctx context.Context, req *pb.Ad
 	newXPs := make(map[string]uint64, len(ids))
 
 	for _, id := range ids {
-		newXP, err := s.svc.AddExperienceByID(ctx, id, req.Reason, int(req.Experience));
+		result, err := s.svc.AddExperienceByID(ctx, id, req.Reason, int(req.Experience))
 
 		if err != nil {
 			return nil, fmt.Errorf("error adding experience to player %s: %w", id.String(), err)
 		}
 
-		newXPs[id.String()] = uint64(newXP)
+		newXPs[id.String()] = uint64(result.NewExperience)
 	}
 
 	return &pb.AddExperienceToPlayersResponse{
@@ -209,7 +209,22 @@ func (s *mcPlayerService) AddExperienceToPlayers(ctx context.Context, req *pb.Ad
 }
 
 func (s *mcPlayerService) GetPlayerExperience(ctx context.Context, req *pb.GetPlayerExperienceRequest) (*pb.GetPlayerExperienceResponse, error) {
-	panic("implement me")
+	pId, err := uuid.Parse(req.PlayerId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid player id %s", req.PlayerId))
+	}
+
+	exp, err := s.repo.GetPlayerExperience(ctx, pId)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("player with id %s not found", req.PlayerId))
+		}
+		return nil, status.Error(codes.Internal, "failed to get player experience")
+	}
+
+	return &pb.GetPlayerExperienceResponse{
+		Experience: uint64(exp.Experience),
+	}, nil
 }
 
 func (s *mcPlayerService) getOrCreateMcPlayer(ctx context.Context, pId uuid.UUID) (*mcplayer.McPlayer, error) {
@@ -12,7 +12,11 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
+	badgeAdminProto "mc-player-service/gen/go/grpc/badgeadmin"
+	experienceProto "mc-player-service/gen/go/grpc/experience"
 	"mc-player-service/internal/app/badge"
+	"mc-player-service/internal/app/badgejob"
+	"mc-player-service/internal/app/catalogue"
 	"mc-player-service/internal/app/player"
 	"mc-player-service/internal/config"
 	"mc-player-service/internal/healthprovider"
@@ -22,7 +26,8 @@ import (
 )
 
 func RunServices(ctx context.Context, log *zap.SugaredLogger, wg *sync.WaitGroup, cfg config.Config,
-	badgeSvc badge.Service, badgeCfg config.BadgeConfig, playerSvc player.Service, repo repository.Repository) {
+	badgeSvc badge.Service, badgeCfg *config.BadgeConfigHolder, catalogueSvc catalogue.Service, jobSvc badgejob.Service,
+	playerSvc player.Service, repo repository.Repository) {
 
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Port))
 	if err != nil {
@@ -48,7 +53,9 @@ func RunServices(ctx context.Context, log *zap.SugaredLogger, wg *sync.WaitGroup
 	grpc_health_v1.RegisterHealthServer(s, healthSrv)
 	mcplayer.RegisterMcPlayerServer(s, newMcPlayerService(repo, playerSvc))
 	badgeProto.RegisterBadgeManagerServer(s, newBadgeService(repo, badgeSvc, badgeCfg))
+	badgeAdminProto.RegisterBadgeAdminServer(s, newBadgeAdminService(repo, badgeSvc, catalogueSvc, jobSvc))
 	mcplayer.RegisterPlayerTrackerServer(s, newPlayerTrackerService(repo))
+	experienceProto.RegisterExperienceManagerServer(s, newExperienceService(playerSvc))
 	log.Infow("listening for gRPC requests", "port", cfg.Port)
 
 	go func() {
@@ -0,0 +1,54 @@
+package kafkaConsumer
+
+import (
+	"context"
+	"fmt"
+	"github.com/emortalmc/proto-specs/gen/go/nongenerated/kafkautils"
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	catalogueMsg "mc-player-service/gen/go/message/badgeadmin"
+	"mc-player-service/internal/config"
+	"sync"
+)
+
+const catalogueTopic = "mc-player-badge-catalogue"
+
+// NewCatalogueListener calls onChange each time the badge catalogue is changed.
+// Unlike NewConsumer, the reader isn't part of a consumer group so every replica receives every change.
+// Readers outside a group only read a single partition, so the topic must only have one.
+func NewCatalogueListener(ctx context.Context, wg *sync.WaitGroup, cfg config.KafkaConfig, log *zap.SugaredLogger,
+	onChange func(ctx context.Context)) {
+
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers: []string{fmt.Sprintf("%s:%d", cfg.Host, cfg.Port)},
+		Topic:   catalogueTopic,
+
+		ErrorLogger: kafka.LoggerFunc(func(format string, args ...interface{}) {
+			log.Errorw(fmt.Sprintf(format, args...))
+		}),
+	})
+
+	// Changes made before startup are already loaded. StartOffset is only used by readers in a group,
+	// so the offset is set directly to stop the whole topic being replayed.
+	if err := reader.SetOffset(kafka.LastOffset); err != nil {
+		log.Errorw("failed to skip to the latest badge catalogue change", "error", err)
+	}
+
+	handler := kafkautils.NewConsumerHandler(log, reader)
+	handler.RegisterHandler(&catalogueMsg.BadgeCatalogueChangedMessage{}, func(ctx context.Context, _ *kafka.Message, uncastMsg proto.Message) {
+		m := uncastMsg.(*catalogueMsg.BadgeCatalogueChangedMessage)
+		log.Debugw("badge catalogue changed", "badgeIds", m.BadgeIds)
+
+		onChange(ctx)
+	})
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		handler.Run(ctx) // Run is blocking until the context is cancelled
+		if err := reader.Close(); err != nil {
+			log.Errorw("error closing kafka reader", "error", err)
+		}
+	}()
+}
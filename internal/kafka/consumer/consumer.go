@@ -12,6 +12,8 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
+	"math"
+	expmsg "mc-player-service/gen/go/message/experience"
 	"mc-player-service/internal/app/badge"
 	"mc-player-service/internal/app/player"
 	"mc-player-service/internal/config"
@@ -22,6 +24,7 @@ import (
 
 const connectionsTopic = "mc-connections"
 const permissionsTopic = "permission-manager"
+const experienceGrantTopic = "mc-player-experience-grant"
 
 type consumer struct {
 	log       *zap.SugaredLogger
@@ -38,7 +41,7 @@ func NewConsumer(ctx context.Context, wg *sync.WaitGroup, config config.Config,
 	reader := kafka.NewReader(kafka.ReaderConfig{
 		Brokers:     []string{fmt.Sprintf("%s:%d", config.Kafka.Host, config.Kafka.Port)},
 		GroupID:     "mc-player-service",
-		GroupTopics: []string{connectionsTopic, permissionsTopic},
+		GroupTopics: []string{connectionsTopic, permissionsTopic, experienceGrantTopic},
 
 		Logger: kafka.LoggerFunc(func(format string, args ...interface{}) {
 			log.Infow(fmt.Sprintf(format, args...))
@@ -63,6 +66,7 @@ func NewConsumer(ctx context.Context, wg *sync.WaitGroup, config config.Config,
 	handler.RegisterHandler(&common.PlayerDisconnectMessage{}, c.handlePlayerDisconnectMessage)
 	handler.RegisterHandler(&common.PlayerSwitchServerMessage{}, c.handlePlayerSwitchServerMessage)
 	handler.RegisterHandler(&permmsg.PlayerRolesUpdateMessage{}, c.handlePlayerRolesUpdateMessage)
+	handler.RegisterHandler(&expmsg.ExperienceGrantMessage{}, c.handleExperienceGrantMessage)
 
 	log.Infow("starting listening for kafka messages", "topics", reader.Config().GroupTopics)
 
@@ -133,3 +137,46 @@ func (c *consumer) handlePlayerRolesUpdateMessage(ctx context.Context, _ *kafka.
 
 	c.badgeSvc.HandlePlayerRolesUpdate(ctx, pID, m.RoleId, m.ChangeType)
 }
+
+func (c *consumer) handleExperienceGrantMessage(ctx context.Context, _ *kafka.Message, uncastMsg proto.Message) {
+	m := uncastMsg.(*expmsg.ExperienceGrantMessage)
+
+	if m.IdempotencyKey == "" {
+		c.log.Errorw("experience grant message has no idempotency key", "reason", m.Reason)
+		return
+	}
+	// The amount would wrap around to a negative int, which can never be granted
+	if m.Experience > math.MaxInt32 {
+		c.log.Errorw("experience grant message amount is too large", "reason", m.Reason, "experience", m.Experience,
+			"idempotencyKey", m.IdempotencyKey)
+		return
+	}
+
+	for _, id := range m.PlayerIds {
+		pID, err := uuid.Parse(id)
+		if err != nil {
+			c.log.Errorw("error parsing player id", "error", err)
+			continue
+		}
+
+		_, err = c.playerSvc.AddIdempotentExperienceByID(ctx, pID, m.Reason, int(m.Experience), m.IdempotencyKey)
+		if err != nil {
+			if errors.Is(err, player.ErrDuplicateGrant) {
+				c.log.Debugw("skipping duplicate experience grant", "playerId", pID, "idempotencyKey", m.IdempotencyKey)
+				continue
+			}
+			if player.IsPermanentGrantError(err) {
+				c.log.Errorw("dropping experience grant that can't succeed", "playerId", pID, "reason", m.Reason,
+					"experience", m.Experience, "idempotencyKey", m.IdempotencyKey, "error", err)
+				continue
+			}
+
+			// The message has already been committed, so the grant is queued to be retried rather than lost
+			c.log.Errorw("error adding experience to player, queueing retry", "playerId", pID, "idempotencyKey", m.IdempotencyKey, "error", err)
+			if err := c.playerSvc.QueueFailedExperienceGrant(ctx, pID, m.Reason, int(m.Experience), m.IdempotencyKey, err); err != nil {
+				c.log.Errorw("failed to queue experience grant retry", "playerId", pID, "idempotencyKey", m.IdempotencyKey,
+					"experience", m.Experience, "error", err)
+			}
+		}
+	}
+}
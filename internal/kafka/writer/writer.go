@@ -3,17 +3,25 @@ package kafkaWriter
 import (
 	"context"
 	"fmt"
+	badgemsg "github.com/emortalmc/proto-specs/gen/go/message/badge"
+	pbmodel "github.com/emortalmc/proto-specs/gen/go/model/badge"
 	"github.com/emortalmc/proto-specs/gen/go/model/mcplayer"
 	"github.com/google/uuid"
 	"github.com/segmentio/kafka-go"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
+	catalogueMsg "mc-player-service/gen/go/message/badgeadmin"
+	expmsg "mc-player-service/gen/go/message/experience"
 	"mc-player-service/internal/config"
 	"sync"
 	"time"
 )
 
-const experienceWriterTopic = "player-experience"
+const (
+	experienceWriterTopic = "player-experience"
+	badgeWriterTopic      = "player-badges"
+	catalogueWriterTopic  = "mc-player-badge-catalogue"
+)
 
 type Notifier struct {
 	logger *zap.SugaredLogger
@@ -21,10 +29,10 @@ type Notifier struct {
 }
 
 func NewKafkaNotifier(ctx context.Context, wg *sync.WaitGroup, cfg config.KafkaConfig, logger *zap.SugaredLogger) *Notifier {
+	// Messages about a player are keyed by their ID, so the hash balancer keeps them in order on a single partition
 	w := &kafka.Writer{
 		Addr:         kafka.TCP(cfg.Host),
-		Topic:        experienceWriterTopic,
-		Balancer:     &kafka.LeastBytes{},
+		Balancer:     &kafka.Hash{},
 		Async:        true,
 		BatchTimeout: 500 * time.Millisecond,
 		ErrorLogger:  kafka.LoggerFunc(logger.Errorw),
@@ -55,21 +63,87 @@ func (n *Notifier) PlayerExperienceChange(ctx context.Context, playerID uuid.UUI
 		NewLevel:           int32(newLevel),
 	}
 
-	if err := n.writeMessage(ctx, msg); err != nil {
+	if err := n.writeMessage(ctx, experienceWriterTopic, playerID.String(), msg); err != nil {
+		n.logger.Errorw("failed to write message", "err", err)
+		return
+	}
+}
+
+func (n *Notifier) PlayerPrestige(ctx context.Context, playerID uuid.UUID, newPrestige int, oldXP int) {
+	msg := &expmsg.PlayerPrestigeMessage{
+		PlayerId:           playerID.String(),
+		NewPrestige:        uint32(newPrestige),
+		PreviousExperience: int64(oldXP),
+	}
+
+	if err := n.writeMessage(ctx, experienceWriterTopic, playerID.String(), msg); err != nil {
+		n.logger.Errorw("failed to write message", "err", err)
+		return
+	}
+}
+
+func (n *Notifier) PlayerBadgeAdded(ctx context.Context, playerID uuid.UUID, badge *pbmodel.Badge) {
+	msg := &badgemsg.PlayerBadgeAddedMessage{
+		PlayerId: playerID.String(),
+		Badge:    badge,
+	}
+
+	if err := n.writeMessage(ctx, badgeWriterTopic, playerID.String(), msg); err != nil {
+		n.logger.Errorw("failed to write message", "err", err)
+		return
+	}
+}
+
+func (n *Notifier) PlayerBadgeRemoved(ctx context.Context, playerID uuid.UUID, badge *pbmodel.Badge) {
+	msg := &badgemsg.PlayerBadgeRemovedMessage{
+		PlayerId: playerID.String(),
+		Badge:    badge,
+	}
+
+	if err := n.writeMessage(ctx, badgeWriterTopic, playerID.String(), msg); err != nil {
+		n.logger.Errorw("failed to write message", "err", err)
+		return
+	}
+}
+
+// PlayerActiveBadgeChanged badge is nil if the player no longer has an active badge
+func (n *Notifier) PlayerActiveBadgeChanged(ctx context.Context, playerID uuid.UUID, badge *pbmodel.Badge) {
+	msg := &badgemsg.PlayerActiveBadgeChangedMessage{
+		PlayerId: playerID.String(),
+		Badge:    badge,
+	}
+
+	if err := n.writeMessage(ctx, badgeWriterTopic, playerID.String(), msg); err != nil {
+		n.logger.Errorw("failed to write message", "err", err)
+		return
+	}
+}
+
+func (n *Notifier) BadgeCatalogueChanged(ctx context.Context, badgeIDs []string) {
+	msg := &catalogueMsg.BadgeCatalogueChangedMessage{BadgeIds: badgeIDs}
+
+	if err := n.writeMessage(ctx, catalogueWriterTopic, "", msg); err != nil {
 		n.logger.Errorw("failed to write message", "err", err)
 		return
 	}
 }
 
-func (n *Notifier) writeMessage(ctx context.Context, msg proto.Message) error {
+// writeMessage the topic is set per message rather than on the writer, as kafka-go rejects messages
+// that set a topic when the writer already has one. Messages without a key are spread across partitions.
+func (n *Notifier) writeMessage(ctx context.Context, topic string, key string, msg proto.Message) error {
 	bytes, err := proto.Marshal(msg)
 	if err != nil {
 		return fmt.Errorf("failed to marshal proto to bytes: %s", err)
 	}
 
-	return n.w.WriteMessages(ctx, kafka.Message{
-		Topic:   experienceWriterTopic,
+	kafkaMsg := kafka.Message{
+		Topic:   topic,
 		Headers: []kafka.Header{{Key: "X-Proto-Type", Value: []byte(msg.ProtoReflect().Descriptor().FullName())}},
 		Value:   bytes,
-	})
+	}
+	if key != "" {
+		kafkaMsg.Key = []byte(key)
+	}
+
+	return n.w.WriteMessages(ctx, kafkaMsg)
 }
@@ -7,6 +7,9 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"mc-player-service/gen/go/grpc/badgeadmin"
+	"mc-player-service/gen/go/grpc/experience"
+	"mc-player-service/internal/config"
 	"time"
 )
 
@@ -21,15 +24,34 @@ type Player struct {
 	LastOnline    time.Time     `bson:"lastOnline"`
 	TotalPlaytime time.Duration `bson:"totalPlaytime"`
 
+	// LastLogin unlike LastOnline, this is only updated when the player logs in
+	LastLogin time.Time `bson:"lastLogin"`
+	// LoginStreak the number of consecutive days (UTC) the player has logged in on, up to and including LastLogin
+	LoginStreak int `bson:"loginStreak,omitempty"`
+
 	// Badges IDs of the badges the player has
 	Badges []string `bson:"badges,omitempty"`
+	// BadgeGrants when each badge was granted. Badges granted before grants were tracked have no entry.
+	BadgeGrants []BadgeGrant `bson:"badgeGrants,omitempty"`
 
 	// ActiveBadge ID of the badge the player has currently active (nil if none)
 	ActiveBadge *string `bson:"activeBadge,omitempty"`
+	// ActiveBadgeManual true if the player chose their ActiveBadge (or chose to show none), in which case it's
+	// only changed automatically if they lose the badge or own a forced badge
+	ActiveBadgeManual bool `bson:"activeBadgeManual,omitempty"`
 
 	CurrentServer *CurrentServer `bson:"currentServer,omitempty"`
 
 	Experience int64 `bson:"experience,omitempty"`
+	// Prestige the number of times the player has reset their experience at the level cap
+	Prestige int `bson:"prestige,omitempty"`
+	// SeasonExperience experience earned in each season that has not yet been archived, keyed by season ID
+	SeasonExperience map[string]int64 `bson:"seasonExperience,omitempty"`
+	// GameModeExperience experience earned in each game mode, keyed by game mode ID
+	GameModeExperience map[string]int64 `bson:"gameModeExperience,omitempty"`
+
+	// GitHubUsername the lowercase username of the player's linked GitHub account (nil if not linked)
+	GitHubUsername *string `bson:"gitHubUsername,omitempty"`
 }
 
 func (p Player) IsEmpty() bool {
@@ -74,10 +96,97 @@ func (p OnlinePlayer) ToProto() *mcplayer.OnlinePlayer {
 	}
 }
 
+var GitHubPlayerProjection = map[string]interface{}{
+	"_id":            1,
+	"gitHubUsername": 1,
+	"badges":         1,
+}
+
+// GitHubPlayer a partial player object for players with a linked GitHub account
+type GitHubPlayer struct {
+	ID             uuid.UUID `bson:"_id"`
+	GitHubUsername string    `bson:"gitHubUsername"`
+	Badges         []string  `bson:"badges,omitempty"`
+}
+
+// CatalogueBadge a badge stored in the badge catalogue
+type CatalogueBadge struct {
+	config.Badge `bson:",inline"`
+
+	// Archived badges aren't loaded into the badge config but are kept so they can be restored
+	Archived  bool      `bson:"archived,omitempty"`
+	UpdatedAt time.Time `bson:"updatedAt"`
+}
+
+func (b CatalogueBadge) ToProto() *badgeadmin.BadgeDefinition {
+	definition := b.Badge.ToDefinitionProto()
+	definition.Archived = b.Archived
+
+	return definition
+}
+
+var CriteriaPlayerProjection = map[string]interface{}{
+	"_id":           1,
+	"firstLogin":    1,
+	"totalPlaytime": 1,
+	"lastLogin":     1,
+	"loginStreak":   1,
+	"experience":    1,
+	"badges":        1,
+}
+
+// CriteriaPlayer a partial player object containing everything badge criteria are evaluated against
+type CriteriaPlayer struct {
+	ID uuid.UUID `bson:"_id"`
+
+	FirstLogin    time.Time     `bson:"firstLogin"`
+	TotalPlaytime time.Duration `bson:"totalPlaytime"`
+	LastLogin     time.Time     `bson:"lastLogin"`
+	LoginStreak   int           `bson:"loginStreak,omitempty"`
+	Experience    int64         `bson:"experience,omitempty"`
+
+	BadgeIDs []string `bson:"badges,omitempty"`
+}
+
+// CurrentLoginStreak returns LoginStreak, or 0 if the streak has ended because the player didn't log in yesterday or today
+func (p CriteriaPlayer) CurrentLoginStreak(now time.Time) int {
+	if p.LastLogin.IsZero() || daysBetween(p.LastLogin, now) > 1 {
+		return 0
+	}
+
+	return p.LoginStreak
+}
+
+// daysBetween returns the number of UTC calendar days from a to b
+func daysBetween(a time.Time, b time.Time) int {
+	aY, aM, aD := a.UTC().Date()
+	bY, bM, bD := b.UTC().Date()
+
+	return int(time.Date(bY, bM, bD, 0, 0, 0, 0, time.UTC).Sub(time.Date(aY, aM, aD, 0, 0, 0, 0, time.UTC)).Hours() / 24)
+}
+
+// UpdateLoginStreak records a login at the given time, extending the player's streak if they last logged in yesterday
+func (p *Player) UpdateLoginStreak(login time.Time) {
+	switch {
+	case p.LastLogin.IsZero() || p.LoginStreak == 0:
+		p.LoginStreak = 1
+	case daysBetween(p.LastLogin, login) == 1:
+		p.LoginStreak++
+	case daysBetween(p.LastLogin, login) > 1:
+		p.LoginStreak = 1
+	}
+
+	if login.After(p.LastLogin) {
+		p.LastLogin = login
+	}
+}
+
 var BadgePlayerProjection = map[string]interface{}{
-	"_id":         1,
-	"badges":      1,
-	"activeBadge": 1,
+	"_id":               1,
+	"badges":            1,
+	"badgeGrants":       1,
+	"activeBadge":       1,
+	"activeBadgeManual": 1,
 }
 
 type BadgePlayer struct {
@@ -85,9 +194,223 @@ type BadgePlayer struct {
 
 	// BadgeIDs IDs of the badge the player has
 	BadgeIDs []string `bson:"badges,omitempty"`
+	// BadgeGrants when each badge was granted. Badges granted before grants were tracked have no entry.
+	BadgeGrants []BadgeGrant `bson:"badgeGrants,omitempty"`
 
 	// ActiveBadge ID of the badge the player has currently active (nil if none)
 	ActiveBadge *string `bson:"activeBadge,omitempty"`
+	// ActiveBadgeManual true if the player chose their ActiveBadge (or chose to show none), in which case it's
+	// only changed automatically if they lose the badge or own a forced badge
+	ActiveBadgeManual bool `bson:"activeBadgeManual,omitempty"`
+}
+
+// GetGrant returns the grant for the badge, or false if the player doesn't have one
+func (p BadgePlayer) GetGrant(badgeID string) (BadgeGrant, bool) {
+	for _, grant := range p.BadgeGrants {
+		if grant.BadgeID == badgeID {
+			return grant, true
+		}
+	}
+
+	return BadgeGrant{}, false
+}
+
+type BadgeGrant struct {
+	BadgeID string `bson:"badgeId"`
+	// GrantedAt zero if the badge was granted before grants were tracked
+	GrantedAt time.Time `bson:"grantedAt"`
+	// ExpiresAt nil if the badge is permanent
+	ExpiresAt *time.Time `bson:"expiresAt,omitempty"`
+}
+
+func (g BadgeGrant) ToProto() *badgeadmin.BadgeGrant {
+	proto := &badgeadmin.BadgeGrant{
+		BadgeId: g.BadgeID,
+	}
+	if !g.GrantedAt.IsZero() {
+		proto.GrantedAt = timestamppb.New(g.GrantedAt)
+	}
+	if g.ExpiresAt != nil {
+		proto.ExpiresAt = timestamppb.New(*g.ExpiresAt)
+	}
+
+	return proto
+}
+
+// ExpiredBadgeGrant a badge grant that has expired but not yet been removed
+type ExpiredBadgeGrant struct {
+	PlayerID    uuid.UUID `bson:"_id"`
+	BadgeID     string    `bson:"badgeId"`
+	ActiveBadge *string   `bson:"activeBadge,omitempty"`
+}
+
+type BadgeAuditRecord struct {
+	ID       primitive.ObjectID `bson:"_id"`
+	PlayerID uuid.UUID          `bson:"playerId"`
+	// BadgeID empty for a set_active or reset_active record if the player is left with no badge
+	BadgeID string `bson:"badgeId"`
+	// Action e.g. grant, revoke, set_active, reset_active or expire
+	Action string `bson:"action"`
+
+	// Actor who made the change, e.g. a staff member's name or "automatic"
+	Actor string `bson:"actor"`
+	// Source what made the change, e.g. "grpc" or "github"
+	Source string `bson:"source,omitempty"`
+	Reason string `bson:"reason,omitempty"`
+
+	Timestamp time.Time `bson:"timestamp"`
+}
+
+func (r BadgeAuditRecord) ToProto() *badgeadmin.BadgeAuditRecord {
+	return &badgeadmin.BadgeAuditRecord{
+		Id:        r.ID.Hex(),
+		PlayerId:  r.PlayerID.String(),
+		BadgeId:   r.BadgeID,
+		Action:    r.Action,
+		Actor:     r.Actor,
+		Source:    r.Source,
+		Reason:    r.Reason,
+		Timestamp: timestamppb.New(r.Timestamp),
+	}
+}
+
+const (
+	BadgeJobActionGrant  = "grant"
+	BadgeJobActionRevoke = "revoke"
+
+	BadgeJobStatusPending   = "pending"
+	BadgeJobStatusRunning   = "running"
+	BadgeJobStatusCompleted = "completed"
+	BadgeJobStatusCancelled = "cancelled"
+	BadgeJobStatusFailed    = "failed"
+)
+
+// BadgeJob grants or revokes a badge for many players in batches. Players are processed in ID order
+// so that an interrupted job can resume after LastPlayerID.
+type BadgeJob struct {
+	ID      primitive.ObjectID `bson:"_id"`
+	Action  string             `bson:"action"`
+	BadgeID string             `bson:"badgeId"`
+	// ExpiresAt when granted badges expire, nil if they are permanent
+	ExpiresAt *time.Time `bson:"expiresAt,omitempty"`
+
+	// PlayerIDs the players to process if PlayerFilter is nil
+	PlayerIDs    []uuid.UUID     `bson:"playerIds,omitempty"`
+	PlayerFilter *BadgeJobFilter `bson:"playerFilter,omitempty"`
+
+	Status string `bson:"status"`
+	// Error why the job failed
+	Error string `bson:"error,omitempty"`
+	// Total the number of players matched when the job was created
+	Total    int64            `bson:"total"`
+	Progress BadgeJobProgress `bson:"progress"`
+	// LastPlayerID the last player that was processed, uuid.Nil if none have been
+	LastPlayerID uuid.UUID `bson:"lastPlayerId"`
+	// LeaseExpiresAt the job is only run by one replica at a time. If that replica stops,
+	// another resumes the job once the lease has expired.
+	LeaseExpiresAt *time.Time `bson:"leaseExpiresAt,omitempty"`
+	// LeaseToken changes each time the job is claimed, so a replica whose lease expired can't overwrite the job
+	LeaseToken string `bson:"leaseToken,omitempty"`
+
+	Actor  string `bson:"actor"`
+	Reason string `bson:"reason,omitempty"`
+
+	CreatedAt   time.Time  `bson:"createdAt"`
+	UpdatedAt   time.Time  `bson:"updatedAt"`
+	CompletedAt *time.Time `bson:"completedAt,omitempty"`
+}
+
+// BadgeJobFilter matches players that meet every condition that isn't nil
+type BadgeJobFilter struct {
+	FirstLoginAfter  *time.Time     `bson:"firstLoginAfter,omitempty"`
+	FirstLoginBefore *time.Time     `bson:"firstLoginBefore,omitempty"`
+	MinLevel         *int           `bson:"minLevel,omitempty"`
+	MinPlaytime      *time.Duration `bson:"minPlaytime,omitempty"`
+}
+
+type BadgeJobProgress struct {
+	Processed int64 `bson:"processed"`
+	// Changed players that were granted or revoked the badge
+	Changed int64 `bson:"changed"`
+	// Skipped players that already had (or didn't have) the badge
+	Skipped int64 `bson:"skipped"`
+	Failed  int64 `bson:"failed"`
+}
+
+func (j BadgeJob) ToProto() *badgeadmin.BadgeJob {
+	proto := &badgeadmin.BadgeJob{
+		Id:        j.ID.Hex(),
+		Action:    j.Action,
+		BadgeId:   j.BadgeID,
+		Status:    j.Status,
+		Total:     uint64(j.Total),
+		Processed: uint64(j.Progress.Processed),
+		Changed:   uint64(j.Progress.Changed),
+		Skipped:   uint64(j.Progress.Skipped),
+		Failed:    uint64(j.Progress.Failed),
+		Actor:     j.Actor,
+		Reason:    j.Reason,
+		CreatedAt: timestamppb.New(j.CreatedAt),
+		UpdatedAt: timestamppb.New(j.UpdatedAt),
+	}
+
+	if j.ExpiresAt != nil {
+		proto.ExpiresAt = timestamppb.New(*j.ExpiresAt)
+	}
+	if j.PlayerFilter != nil {
+		proto.PlayerFilter = j.PlayerFilter.ToProto()
+	}
+	if j.Error != "" {
+		proto.Error = &j.Error
+	}
+	if j.CompletedAt != nil {
+		proto.CompletedAt = timestamppb.New(*j.CompletedAt)
+	}
+
+	return proto
+}
+
+func (f *BadgeJobFilter) ToProto() *badgeadmin.BadgeJobPlayerFilter {
+	proto := &badgeadmin.BadgeJobPlayerFilter{}
+
+	if f.FirstLoginAfter != nil {
+		proto.FirstLoginAfter = timestamppb.New(*f.FirstLoginAfter)
+	}
+	if f.FirstLoginBefore != nil {
+		proto.FirstLoginBefore = timestamppb.New(*f.FirstLoginBefore)
+	}
+	if f.MinLevel != nil {
+		minLevel := int32(*f.MinLevel)
+		proto.MinLevel = &minLevel
+	}
+	if f.MinPlaytime != nil {
+		proto.MinPlaytime = durationpb.New(*f.MinPlaytime)
+	}
+
+	return proto
+}
+
+func BadgeJobFilterFromProto(proto *badgeadmin.BadgeJobPlayerFilter) *BadgeJobFilter {
+	filter := &BadgeJobFilter{}
+
+	if proto.FirstLoginAfter != nil {
+		firstLoginAfter := proto.FirstLoginAfter.AsTime()
+		filter.FirstLoginAfter = &firstLoginAfter
+	}
+	if proto.FirstLoginBefore != nil {
+		firstLoginBefore := proto.FirstLoginBefore.AsTime()
+		filter.FirstLoginBefore = &firstLoginBefore
+	}
+	if proto.MinLevel != nil {
+		minLevel := int(*proto.MinLevel)
+		filter.MinLevel = &minLevel
+	}
+	if proto.MinPlaytime != nil {
+		minPlaytime := proto.MinPlaytime.AsDuration()
+		filter.MinPlaytime = &minPlaytime
+	}
+
+	return filter
 }
 
 type CurrentServer struct {
@@ -184,6 +507,117 @@ type PlayerUsername struct {
 type ExperienceTransaction struct {
 	ID       primitive.ObjectID `bson:"_id"`
 	PlayerID uuid.UUID          `bson:"playerId"`
-	Amount   int64              `bson:"amount"`
-	Reason   string             `bson:"reason"`
+
+	// BaseAmount the amount requested before any multipliers were applied
+	BaseAmount  int64               `bson:"baseAmount"`
+	Multipliers []AppliedMultiplier `bson:"multipliers,omitempty"`
+	// Amount the final amount of experience given to the player
+	Amount int64 `bson:"amount"`
+	// Capped true if Amount was reduced because the player hit a cap for the Reason or the level cap
+	Capped bool `bson:"capped,omitempty"`
+
+	Reason string `bson:"reason"`
+	// SeasonID the season that was running when the transaction was created, if any
+	SeasonID string `bson:"seasonId,omitempty"`
+	// GameModeID the game mode track the experience also counted towards, if any
+	GameModeID string `bson:"gameModeId,omitempty"`
+	// IdempotencyKey only present for grants that were made through Kafka
+	IdempotencyKey string `bson:"idempotencyKey,omitempty"`
+
+	// RevokedBy the ID of the compensating transaction if this transaction has been revoked
+	RevokedBy *primitive.ObjectID `bson:"revokedBy,omitempty"`
+	// Revokes the ID of the original transaction if this is a compensating transaction.
+	// Compensating transactions have a negative Amount and keep the Reason of the original.
+	Revokes          *primitive.ObjectID `bson:"revokes,omitempty"`
+	RevocationReason string              `bson:"revocationReason,omitempty"`
+}
+
+func (t ExperienceTransaction) IsRevocation() bool {
+	return t.Revokes != nil
+}
+
+// IsRevocable returns false for transactions that didn't grant experience, such as revocations and prestiges,
+// as revoking them would give the player experience
+func (t ExperienceTransaction) IsRevocable() bool {
+	return !t.IsRevocation() && t.Amount > 0
+}
+
+var PlayerExperienceProjection = map[string]interface{}{
+	"_id":        1,
+	"experience": 1,
+	"prestige":   1,
+}
+
+// PlayerExperience a partial player object containing only their global experience
+type PlayerExperience struct {
+	ID         uuid.UUID `bson:"_id"`
+	Experience int64     `bson:"experience,omitempty"`
+	Prestige   int       `bson:"prestige,omitempty"`
+}
+
+// ExperienceTracks the tracks that experience is added to alongside a player's lifetime experience.
+// Empty IDs are ignored.
+type ExperienceTracks struct {
+	SeasonID   string
+	GameModeID string
+}
+
+type AppliedMultiplier struct {
+	// Source e.g. "event:double_xp_weekend" or "booster:<id>"
+	Source     string  `bson:"source"`
+	Multiplier float64 `bson:"multiplier"`
+}
+
+type ExperienceBooster struct {
+	ID         primitive.ObjectID `bson:"_id"`
+	PlayerID   uuid.UUID          `bson:"playerId"`
+	Multiplier float64            `bson:"multiplier"`
+	Source     string             `bson:"source"`
+	ExpiresAt  time.Time          `bson:"expiresAt"`
+}
+
+func (b ExperienceBooster) ToProto() *experience.ExperienceBooster {
+	return &experience.ExperienceBooster{
+		Id:         b.ID.Hex(),
+		PlayerId:   b.PlayerID.String(),
+		Multiplier: b.Multiplier,
+		Source:     b.Source,
+		ExpiresAt:  timestamppb.New(b.ExpiresAt),
+	}
+}
+
+// FailedExperienceGrant an experience grant from Kafka that failed and is retried in the background
+type FailedExperienceGrant struct {
+	ID             primitive.ObjectID `bson:"_id"`
+	PlayerID       uuid.UUID          `bson:"playerId"`
+	Reason         string             `bson:"reason"`
+	Experience     int64              `bson:"experience"`
+	IdempotencyKey string             `bson:"idempotencyKey"`
+
+	Attempts      int       `bson:"attempts"`
+	LastError     string    `bson:"lastError"`
+	NextAttemptAt time.Time `bson:"nextAttemptAt"`
+}
+
+// LevelRewardClaim records that a player has been given the rewards for a level so they are never given twice
+type LevelRewardClaim struct {
+	ID       primitive.ObjectID `bson:"_id"`
+	PlayerID uuid.UUID          `bson:"playerId"`
+	Level    int                `bson:"level"`
+}
+
+// ExperienceMismatch a player whose stored experience differs from the sum of their experience transactions
+type ExperienceMismatch struct {
+	PlayerID         uuid.UUID `bson:"_id"`
+	Experience       int64     `bson:"experience"`
+	LedgerExperience int64     `bson:"ledgerExperience"`
+}
+
+// SeasonResult a player's archived experience for a season that has ended
+type SeasonResult struct {
+	ID         primitive.ObjectID `bson:"_id"`
+	PlayerID   uuid.UUID          `bson:"playerId"`
+	SeasonID   string             `bson:"seasonId"`
+	Experience int64              `bson:"experience"`
+	Level      int                `bson:"level"`
 }
@@ -21,6 +21,15 @@ const (
 	sessionCollectionName               = "loginSession"
 	usernameCollectionName              = "playerUsername"
 	experienceTransactionCollectionName = "experienceTransaction"
+	experienceBoosterCollectionName     = "experienceBooster"
+	levelRewardClaimCollectionName      = "levelRewardClaim"
+	failedExperienceGrantCollectionName = "failedExperienceGrant"
+	seasonResultCollectionName          = "seasonResult"
+	badgeAuditCollectionName            = "badgeAudit"
+	badgeCatalogueCollectionName        = "badge"
+	badgeRevisionCollectionName         = "badgeCatalogueRevision"
+	badgeJobCollectionName              = "badgeJob"
+	leaseCollectionName                 = "lease"
 )
 
 type mongoRepository struct {
@@ -30,6 +39,15 @@ type mongoRepository struct {
 	sessionCollection               *mongo.Collection
 	usernameCollection              *mongo.Collection
 	experienceTransactionCollection *mongo.Collection
+	experienceBoosterCollection     *mongo.Collection
+	levelRewardClaimCollection      *mongo.Collection
+	failedExperienceGrantCollection *mongo.Collection
+	seasonResultCollection          *mongo.Collection
+	badgeAuditCollection            *mongo.Collection
+	badgeCatalogueCollection        *mongo.Collection
+	badgeRevisionCollection         *mongo.Collection
+	badgeJobCollection              *mongo.Collection
+	leaseCollection                 *mongo.Collection
 }
 
 func NewMongoRepository(ctx context.Context, log *zap.SugaredLogger, wg *sync.WaitGroup, cfg config.MongoDBConfig) (Repository, error) {
@@ -45,6 +63,15 @@ func NewMongoRepository(ctx context.Context, log *zap.SugaredLogger, wg *sync.Wa
 		sessionCollection:               database.Collection(sessionCollectionName),
 		usernameCollection:              database.Collection(usernameCollectionName),
 		experienceTransactionCollection: database.Collection(experienceTransactionCollectionName),
+		experienceBoosterCollection:     database.Collection(experienceBoosterCollectionName),
+		levelRewardClaimCollection:      database.Collection(levelRewardClaimCollectionName),
+		failedExperienceGrantCollection: database.Collection(failedExperienceGrantCollectionName),
+		seasonResultCollection:          database.Collection(seasonResultCollectionName),
+		badgeAuditCollection:            database.Collection(badgeAuditCollectionName),
+		badgeCatalogueCollection:        database.Collection(badgeCatalogueCollectionName),
+		badgeRevisionCollection:         database.Collection(badgeRevisionCollectionName),
+		badgeJobCollection:              database.Collection(badgeJobCollectionName),
+		leaseCollection:                 database.Collection(leaseCollectionName),
 	}
 
 	wg.Add(1)
@@ -88,6 +115,19 @@ var (
 			Keys:    bson.M{"currentServer.fleetName": 1},
 			Options: options.Index().SetName("currentServer_fleetName"),
 		},
+
+		{
+			Keys:    bson.M{"badges": 1},
+			Options: options.Index().SetName("badges"),
+		},
+		{ // Allows for finding expired badges
+			Keys:    bson.M{"badgeGrants.expiresAt": 1},
+			Options: options.Index().SetName("badgeGrants_expiresAt").SetSparse(true),
+		},
+		{ // A GitHub account can only be linked to one player
+			Keys:    bson.M{"gitHubUsername": 1},
+			Options: options.Index().SetName("gitHubUsername").SetUnique(true).SetSparse(true),
+		},
 	}
 
 	sessionIndexes = []mongo.IndexModel{
@@ -117,6 +157,77 @@ var (
 			Keys:    bson.M{"playerId": 1},
 			Options: options.Index().SetName("playerId"),
 		},
+		{ // Used to enforce experience caps over a rolling window
+			Keys:    bson.D{{Key: "playerId", Value: 1}, {Key: "reason", Value: 1}, {Key: "_id", Value: 1}},
+			Options: options.Index().SetName("playerId_reason_id"),
+		},
+		{ // Used to find transactions to claw back after an exploit
+			Keys:    bson.D{{Key: "reason", Value: 1}, {Key: "_id", Value: 1}},
+			Options: options.Index().SetName("reason_id"),
+		},
+		{ // Guarantees experience is only granted once per idempotency key
+			Keys: bson.D{{Key: "playerId", Value: 1}, {Key: "idempotencyKey", Value: 1}},
+			Options: options.Index().SetName("playerId_idempotencyKey").SetUnique(true).
+				SetPartialFilterExpression(bson.M{"idempotencyKey": bson.M{"$exists": true}}),
+		},
+	}
+
+	experienceBoosterIndexes = []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "playerId", Value: 1}, {Key: "expiresAt", Value: 1}},
+			Options: options.Index().SetName("playerId_expiresAt"),
+		},
+		{ // Expired boosters are never read again so Mongo can clean them up
+			Keys:    bson.M{"expiresAt": 1},
+			Options: options.Index().SetName("expiresAt_ttl").SetExpireAfterSeconds(0),
+		},
+	}
+
+	levelRewardClaimIndexes = []mongo.IndexModel{
+		{ // Guarantees a level's rewards are only claimed once
+			Keys:    bson.D{{Key: "playerId", Value: 1}, {Key: "level", Value: 1}},
+			Options: options.Index().SetName("playerId_level").SetUnique(true),
+		},
+	}
+
+	failedExperienceGrantIndexes = []mongo.IndexModel{
+		{ // Allows for finding grants that are due to be retried
+			Keys:    bson.M{"nextAttemptAt": 1},
+			Options: options.Index().SetName("nextAttemptAt"),
+		},
+	}
+
+	seasonResultIndexes = []mongo.IndexModel{
+		{ // Guarantees a season is only archived once per player
+			Keys:    bson.D{{Key: "playerId", Value: 1}, {Key: "seasonId", Value: 1}},
+			Options: options.Index().SetName("playerId_seasonId").SetUnique(true),
+		},
+		{ // Season leaderboards
+			Keys:    bson.D{{Key: "seasonId", Value: 1}, {Key: "experience", Value: -1}},
+			Options: options.Index().SetName("seasonId_experience"),
+		},
+	}
+
+	badgeAuditIndexes = []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "playerId", Value: 1}, {Key: "timestamp", Value: -1}},
+			Options: options.Index().SetName("playerId_timestamp"),
+		},
+		{
+			Keys:    bson.D{{Key: "badgeId", Value: 1}, {Key: "timestamp", Value: -1}},
+			Options: options.Index().SetName("badgeId_timestamp"),
+		},
+	}
+
+	badgeJobIndexes = []mongo.IndexModel{
+		{ // Allows for finding jobs to run
+			Keys:    bson.D{{Key: "status", Value: 1}, {Key: "createdAt", Value: 1}},
+			Options: options.Index().SetName("status_createdAt"),
+		},
+		{
+			Keys:    bson.M{"createdAt": -1},
+			Options: options.Index().SetName("createdAt"),
+		},
 	}
 )
 
@@ -126,6 +237,12 @@ func (m *mongoRepository) createIndexes(ctx context.Context) {
 		m.sessionCollection:               sessionIndexes,
 		m.usernameCollection:              usernameIndexes,
 		m.experienceTransactionCollection: experienceTransactionIndexes,
+		m.experienceBoosterCollection:     experienceBoosterIndexes,
+		m.levelRewardClaimCollection:      levelRewardClaimIndexes,
+		m.failedExperienceGrantCollection: failedExperienceGrantIndexes,
+		m.seasonResultCollection:          seasonResultIndexes,
+		m.badgeAuditCollection:            badgeAuditIndexes,
+		m.badgeJobCollection:              badgeJobIndexes,
 	}
 
 	wg := sync.WaitGroup{}
@@ -144,6 +261,31 @@ func (m *mongoRepository) createIndexes(ctx context.Context) {
 	wg.Wait()
 }
 
+// CreateSeasonLeaderboardIndexes creates an index for the leaderboard of each season.
+// Seasons come from the config, so unlike other indexes they can't be declared up front.
+func (m *mongoRepository) CreateSeasonLeaderboardIndexes(ctx context.Context, seasonIDs []string) error {
+	if len(seasonIDs) == 0 {
+		return nil
+	}
+
+	indexes := make([]mongo.IndexModel, len(seasonIDs))
+	for i, seasonID := range seasonIDs {
+		field := "seasonExperience." + seasonID
+		indexes[i] = mongo.IndexModel{
+			Keys: bson.M{field: -1},
+			Options: options.Index().SetName("seasonExperience_" + seasonID).
+				SetPartialFilterExpression(bson.M{field: bson.M{"$exists": true}}),
+		}
+	}
+
+	// Building the index for a new season scans every player
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	_, err := m.playerCollection.Indexes().CreateMany(ctx, indexes)
+	return err
+}
+
 func (m *mongoRepository) createCollIndexes(ctx context.Context, coll *mongo.Collection, indexes []mongo.IndexModel) (int, error) {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
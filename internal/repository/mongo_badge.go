@@ -2,10 +2,12 @@ package repository
 
 import (
 	"context"
+	"github.com/emortalmc/proto-specs/gen/go/model/common"
 	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"math"
 	"mc-player-service/internal/repository/model"
 	"time"
 )
@@ -23,19 +25,126 @@ func (m *mongoRepository) GetBadgePlayer(ctx context.Context, playerId uuid.UUID
 	return player, nil
 }
 
-func (m *mongoRepository) UpdatePlayerBadgesAndActive(ctx context.Context, playerId uuid.UUID, badges []string,
-	activeBadge *string) error {
+func (m *mongoRepository) GetBadgePlayersAfter(ctx context.Context, after uuid.UUID, limit int) ([]model.BadgePlayer, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	opts := options.Find().
+		SetProjection(model.BadgePlayerProjection).
+		SetSort(bson.M{"_id": 1}).
+		SetLimit(int64(limit))
 
+	cursor, err := m.playerCollection.Find(ctx, bson.M{"_id": bson.M{"$gt": after}}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var players []model.BadgePlayer
+	if err := cursor.All(ctx, &players); err != nil {
+		return nil, err
+	}
+
+	return players, nil
+}
+
+func (m *mongoRepository) GetCriteriaPlayer(ctx context.Context, playerID uuid.UUID) (model.CriteriaPlayer, error) {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	update := bson.M{"$set": bson.M{"badges": badges}}
-	if activeBadge != nil {
-		update["$set"].(bson.M)["activeBadge"] = activeBadge
-	} else {
-		update["$unset"] = bson.M{"activeBadge": ""}
+	var player model.CriteriaPlayer
+	if err := m.playerCollection.FindOne(ctx, bson.M{"_id": playerID}, options.FindOne().SetProjection(model.CriteriaPlayerProjection)).
+		Decode(&player); err != nil {
+		return model.CriteriaPlayer{}, err
+	}
+
+	return player, nil
+}
+
+func (m *mongoRepository) GetCriteriaPlayersAfter(ctx context.Context, after uuid.UUID, limit int) ([]model.CriteriaPlayer, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	opts := options.Find().
+		SetProjection(model.CriteriaPlayerProjection).
+		SetSort(bson.M{"_id": 1}).
+		SetLimit(int64(limit))
+
+	cursor, err := m.playerCollection.Find(ctx, bson.M{"_id": bson.M{"$gt": after}}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var players []model.CriteriaPlayer
+	if err := cursor.All(ctx, &players); err != nil {
+		return nil, err
+	}
+
+	return players, nil
+}
+
+func (m *mongoRepository) GetBadgeOwnerCounts(ctx context.Context) (map[string]int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	cursor, err := m.playerCollection.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"badges.0": bson.M{"$exists": true}}}},
+		{{Key: "$unwind", Value: "$badges"}},
+		{{Key: "$group", Value: bson.M{"_id": "$badges", "owners": bson.M{"$sum": 1}}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var results []struct {
+		BadgeID string `bson:"_id"`
+		Owners  int64  `bson:"owners"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(results))
+	for _, result := range results {
+		counts[result.BadgeID] = result.Owners
+	}
+
+	return counts, nil
+}
+
+func (m *mongoRepository) GetPlayerIDsWithBadges(ctx context.Context, badgeIDs []string) ([]uuid.UUID, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	cursor, err := m.playerCollection.Find(ctx, bson.M{"badges": bson.M{"$in": badgeIDs}}, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, err
+	}
+
+	var results []struct {
+		ID uuid.UUID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, len(results))
+	for i, result := range results {
+		ids[i] = result.ID
 	}
 
+	return ids, nil
+}
+
+func (m *mongoRepository) UpdatePlayerBadgesAndActive(ctx context.Context, playerId uuid.UUID, badges []string,
+	activeBadge *string, manual bool) error {
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	update := activeBadgeUpdate(activeBadge, manual)
+	update["$set"].(bson.M)["badges"] = badges
+	update["$pull"] = bson.M{"badgeGrants": bson.M{"badgeId": bson.M{"$nin": badges}}}
+
 	if r, err := m.playerCollection.UpdateByID(ctx, playerId, update); err != nil {
 		return err
 	} else if r.MatchedCount == 0 {
@@ -45,11 +154,14 @@ func (m *mongoRepository) UpdatePlayerBadgesAndActive(ctx context.Context, playe
 	return nil
 }
 
-func (m *mongoRepository) AddPlayerBadge(ctx context.Context, playerId uuid.UUID, badgeId string) (int64, error) {
+func (m *mongoRepository) AddPlayerBadge(ctx context.Context, playerId uuid.UUID, badgeId string, expiresAt *time.Time) (int64, error) {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	result, err := m.playerCollection.UpdateOne(ctx, bson.M{"_id": playerId}, bson.M{"$addToSet": bson.M{"badges": badgeId}})
+	grant := model.BadgeGrant{BadgeID: badgeId, GrantedAt: time.Now(), ExpiresAt: expiresAt}
+
+	result, err := m.playerCollection.UpdateOne(ctx, bson.M{"_id": playerId, "badges": bson.M{"$ne": badgeId}},
+		bson.M{"$push": bson.M{"badges": badgeId, "badgeGrants": grant}})
 	if err != nil {
 		return 0, err
 	}
@@ -61,7 +173,10 @@ func (m *mongoRepository) RemovePlayerBadge(ctx context.Context, playerId uuid.U
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	result, err := m.playerCollection.UpdateOne(ctx, bson.M{"_id": playerId}, bson.M{"$pull": bson.M{"badges": badgeId}})
+	result, err := m.playerCollection.UpdateOne(ctx, bson.M{"_id": playerId}, bson.M{"$pull": bson.M{
+		"badges":      badgeId,
+		"badgeGrants": bson.M{"badgeId": badgeId},
+	}})
 	if err != nil {
 		return 0, err
 	}
@@ -69,6 +184,50 @@ func (m *mongoRepository) RemovePlayerBadge(ctx context.Context, playerId uuid.U
 	return result.ModifiedCount, nil
 }
 
+func (m *mongoRepository) GetExpiredBadgeGrants(ctx context.Context, at time.Time, limit int) ([]model.ExpiredBadgeGrant, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	cursor, err := m.playerCollection.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"badgeGrants.expiresAt": bson.M{"$lte": at}}}},
+		{{Key: "$unwind", Value: "$badgeGrants"}},
+		{{Key: "$match", Value: bson.M{"badgeGrants.expiresAt": bson.M{"$lte": at}}}},
+		{{Key: "$limit", Value: limit}},
+		{{Key: "$project", Value: bson.M{"badgeId": "$badgeGrants.badgeId", "activeBadge": 1}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var grants []model.ExpiredBadgeGrant
+	if err := cursor.All(ctx, &grants); err != nil {
+		return nil, err
+	}
+
+	return grants, nil
+}
+
+func (m *mongoRepository) RemoveExpiredPlayerBadge(ctx context.Context, playerID uuid.UUID, badgeID string, at time.Time) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	// Only matches if the badge hasn't been granted again since it was found to be expired
+	filter := bson.M{
+		"_id":         playerID,
+		"badgeGrants": bson.M{"$elemMatch": bson.M{"badgeId": badgeID, "expiresAt": bson.M{"$lte": at}}},
+	}
+
+	result, err := m.playerCollection.UpdateOne(ctx, filter, bson.M{"$pull": bson.M{
+		"badges":      badgeID,
+		"badgeGrants": bson.M{"badgeId": badgeID},
+	}})
+	if err != nil {
+		return false, err
+	}
+
+	return result.ModifiedCount > 0, nil
+}
+
 func (m *mongoRepository) GetActivePlayerBadge(ctx context.Context, playerId uuid.UUID) (*string, error) {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
@@ -85,15 +244,110 @@ func (m *mongoRepository) GetActivePlayerBadge(ctx context.Context, playerId uui
 	return result.ActiveBadge, nil
 }
 
-func (m *mongoRepository) SetActivePlayerBadge(ctx context.Context, playerId uuid.UUID, badgeId *string) error {
+func (m *mongoRepository) SetActivePlayerBadge(ctx context.Context, playerId uuid.UUID, badgeId *string, manual bool) error {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
+	_, err := m.playerCollection.UpdateOne(ctx, bson.M{"_id": playerId}, activeBadgeUpdate(badgeId, manual))
+	return err
+}
+
+// activeBadgeUpdate returns an update that sets the active badge, unsetting it if nil. It always has a $set.
+func activeBadgeUpdate(badgeId *string, manual bool) bson.M {
+	set := bson.M{"activeBadgeManual": manual}
 	if badgeId == nil {
-		_, err := m.playerCollection.UpdateOne(ctx, bson.M{"_id": playerId}, bson.M{"$unset": bson.M{"activeBadge": ""}})
+		return bson.M{"$set": set, "$unset": bson.M{"activeBadge": ""}}
+	}
+
+	set["activeBadge"] = badgeId
+	return bson.M{"$set": set}
+}
+
+func (m *mongoRepository) GetGitHubLinkedPlayers(ctx context.Context) ([]model.GitHubPlayer, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	cursor, err := m.playerCollection.Find(ctx, bson.M{"gitHubUsername": bson.M{"$exists": true}},
+		options.Find().SetProjection(model.GitHubPlayerProjection))
+	if err != nil {
+		return nil, err
+	}
+
+	var players []model.GitHubPlayer
+	if err := cursor.All(ctx, &players); err != nil {
+		return nil, err
+	}
+
+	return players, nil
+}
+
+func (m *mongoRepository) SetPlayerGitHubUsername(ctx context.Context, playerID uuid.UUID, username *string) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	update := bson.M{"$unset": bson.M{"gitHubUsername": ""}}
+	if username != nil {
+		update = bson.M{"$set": bson.M{"gitHubUsername": *username}}
+	}
+
+	result, err := m.playerCollection.UpdateByID(ctx, playerID, update)
+	if err != nil {
 		return err
 	}
+	if result.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
 
-	_, err := m.playerCollection.UpdateOne(ctx, bson.M{"_id": playerId}, bson.M{"$set": bson.M{"activeBadge": badgeId}})
+	return nil
+}
+
+func (m *mongoRepository) CreateBadgeAuditRecord(ctx context.Context, record model.BadgeAuditRecord) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := m.badgeAuditCollection.InsertOne(ctx, record)
 	return err
 }
+
+func (m *mongoRepository) GetBadgeAuditRecords(ctx context.Context, playerID *uuid.UUID, badgeID *string,
+	pageable *common.Pageable) ([]model.BadgeAuditRecord, *common.PageData, error) {
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{}
+	if playerID != nil {
+		filter["playerId"] = *playerID
+	}
+	if badgeID != nil {
+		filter["badgeId"] = *badgeID
+	}
+
+	page := int64(pageable.Page)
+	skip := (page - 1) * int64(*pageable.Size)
+
+	opts := options.Find().SetSort(bson.D{{Key: "timestamp", Value: -1}, {Key: "_id", Value: -1}}).SetSkip(skip).SetLimit(int64(*pageable.Size))
+	cursor, err := m.badgeAuditCollection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var records []model.BadgeAuditRecord
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, nil, err
+	}
+
+	total, err := m.badgeAuditCollection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pageData := &common.PageData{
+		Page:          uint64(page),
+		Size:          uint64(len(records)),
+		TotalElements: uint64(total),
+		TotalPages:    uint64(math.Ceil(float64(total) / float64(*pageable.Size))),
+	}
+
+	return records, pageData, nil
+}
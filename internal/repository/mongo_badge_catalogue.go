@@ -0,0 +1,188 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"mc-player-service/internal/config"
+	"mc-player-service/internal/repository/model"
+	"time"
+)
+
+func (m *mongoRepository) GetCatalogueBadges(ctx context.Context, includeArchived bool) ([]model.CatalogueBadge, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{}
+	if !includeArchived {
+		filter["archived"] = bson.M{"$ne": true}
+	}
+
+	cursor, err := m.badgeCatalogueCollection.Find(ctx, filter, options.Find().SetSort(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, err
+	}
+
+	var badges []model.CatalogueBadge
+	if err := cursor.All(ctx, &badges); err != nil {
+		return nil, err
+	}
+
+	return badges, nil
+}
+
+func (m *mongoRepository) CreateCatalogueBadge(ctx context.Context, badge config.Badge) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := m.badgeCatalogueCollection.InsertOne(ctx, model.CatalogueBadge{Badge: badge, UpdatedAt: time.Now()})
+	return err
+}
+
+func (m *mongoRepository) UpdateCatalogueBadge(ctx context.Context, badge config.Badge) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	// Replaced rather than $set so that fields removed from the badge are removed from the document.
+	// $literal stops text starting with $ being read as a field path.
+	result, err := m.badgeCatalogueCollection.UpdateByID(ctx, badge.Id, mongo.Pipeline{
+		{{Key: "$replaceWith", Value: bson.M{"$mergeObjects": bson.A{
+			bson.M{"$literal": badge},
+			bson.M{"archived": "$archived", "updatedAt": time.Now()},
+		}}}},
+	})
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+
+	return nil
+}
+
+func (m *mongoRepository) UpsertCatalogueBadge(ctx context.Context, badge config.Badge, overwrite bool) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	catalogueBadge := model.CatalogueBadge{Badge: badge, UpdatedAt: time.Now()}
+
+	if !overwrite {
+		result, err := m.badgeCatalogueCollection.UpdateByID(ctx, badge.Id, bson.M{"$setOnInsert": catalogueBadge},
+			options.Update().SetUpsert(true))
+		if err != nil {
+			return false, err
+		}
+
+		return result.UpsertedCount > 0, nil
+	}
+
+	_, err := m.badgeCatalogueCollection.ReplaceOne(ctx, bson.M{"_id": badge.Id}, catalogueBadge, options.Replace().SetUpsert(true))
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (m *mongoRepository) SetCatalogueBadgeArchived(ctx context.Context, badgeID string, archived bool) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	result, err := m.badgeCatalogueCollection.UpdateByID(ctx, badgeID, bson.M{"$set": bson.M{
+		"archived":  archived,
+		"updatedAt": time.Now(),
+	}})
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+
+	return nil
+}
+
+func (m *mongoRepository) SetCatalogueBadgePriorities(ctx context.Context, priorities map[string]int) error {
+	if len(priorities) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	now := time.Now()
+	writes := make([]mongo.WriteModel, 0, len(priorities))
+	for badgeID, priority := range priorities {
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": badgeID}).
+			SetUpdate(bson.M{"$set": bson.M{"priority": priority, "updatedAt": now}}))
+	}
+
+	_, err := m.badgeCatalogueCollection.BulkWrite(ctx, writes)
+	return err
+}
+
+// badgeRevisionID the ID of the only document in the badge catalogue revision collection
+const badgeRevisionID = "catalogue"
+
+type badgeCatalogueRevision struct {
+	Revision int64 `bson:"revision"`
+}
+
+func (m *mongoRepository) GetBadgeCatalogueRevision(ctx context.Context) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var revision badgeCatalogueRevision
+	if err := m.badgeRevisionCollection.FindOne(ctx, bson.M{"_id": badgeRevisionID}).Decode(&revision); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return revision.Revision, nil
+}
+
+func (m *mongoRepository) ClaimBadgeCatalogueRevision(ctx context.Context, revision int64, now time.Time,
+	expiresAt time.Time) (bool, error) {
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	// The revision is 0 before the first change, when there's no document and the upsert creates it.
+	// Otherwise, if the filter doesn't match, the upsert fails as the document already exists.
+	revisionFilter := bson.M{"revision": revision}
+	if revision == 0 {
+		revisionFilter = bson.M{"revision": bson.M{"$in": bson.A{0, nil}}}
+	}
+
+	_, err := m.badgeRevisionCollection.UpdateOne(ctx,
+		bson.M{"$and": bson.A{
+			bson.M{"_id": badgeRevisionID},
+			revisionFilter,
+			bson.M{"$or": bson.A{bson.M{"writingUntil": bson.M{"$exists": false}}, bson.M{"writingUntil": bson.M{"$lte": now}}}},
+		}},
+		bson.M{"$set": bson.M{"revision": revision + 1, "writingUntil": expiresAt}},
+		options.Update().SetUpsert(true))
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (m *mongoRepository) FinishBadgeCatalogueChange(ctx context.Context, revision int64) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := m.badgeRevisionCollection.UpdateOne(ctx, bson.M{"_id": badgeRevisionID, "revision": revision},
+		bson.M{"$unset": bson.M{"writingUntil": ""}})
+	return err
+}
@@ -0,0 +1,226 @@
+package repository
+
+import (
+	"context"
+	"github.com/emortalmc/proto-specs/gen/go/model/common"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"math"
+	"mc-player-service/internal/repository/model"
+	"time"
+)
+
+func (m *mongoRepository) GetBadgeJob(ctx context.Context, id primitive.ObjectID) (model.BadgeJob, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var job model.BadgeJob
+	if err := m.badgeJobCollection.FindOne(ctx, bson.M{"_id": id}).Decode(&job); err != nil {
+		return model.BadgeJob{}, err
+	}
+
+	return job, nil
+}
+
+func (m *mongoRepository) GetBadgeJobs(ctx context.Context, pageable *common.Pageable) ([]model.BadgeJob, *common.PageData, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	page := int64(pageable.Page)
+	skip := (page - 1) * int64(*pageable.Size)
+
+	opts := options.Find().
+		SetProjection(bson.M{"playerIds": 0}).
+		SetSort(bson.D{{Key: "createdAt", Value: -1}, {Key: "_id", Value: -1}}).
+		SetSkip(skip).
+		SetLimit(int64(*pageable.Size))
+
+	cursor, err := m.badgeJobCollection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var jobs []model.BadgeJob
+	if err := cursor.All(ctx, &jobs); err != nil {
+		return nil, nil, err
+	}
+
+	total, err := m.badgeJobCollection.CountDocuments(ctx, bson.M{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pageData := &common.PageData{
+		Page:          uint64(page),
+		Size:          uint64(len(jobs)),
+		TotalElements: uint64(total),
+		TotalPages:    uint64(math.Ceil(float64(total) / float64(*pageable.Size))),
+	}
+
+	return jobs, pageData, nil
+}
+
+func (m *mongoRepository) CountFilteredPlayers(ctx context.Context, filter PlayerFilter) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	return m.playerCollection.CountDocuments(ctx, playerFilterQuery(filter))
+}
+
+func (m *mongoRepository) GetFilteredPlayerIDsAfter(ctx context.Context, filter PlayerFilter, after uuid.UUID, limit int) ([]uuid.UUID, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	query := playerFilterQuery(filter)
+	idQuery, ok := query["_id"].(bson.M)
+	if !ok {
+		idQuery = bson.M{}
+	}
+	idQuery["$gt"] = after
+	query["_id"] = idQuery
+
+	opts := options.Find().
+		SetProjection(bson.M{"_id": 1}).
+		SetSort(bson.M{"_id": 1}).
+		SetLimit(int64(limit))
+
+	cursor, err := m.playerCollection.Find(ctx, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []struct {
+		ID uuid.UUID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, len(results))
+	for i, result := range results {
+		ids[i] = result.ID
+	}
+
+	return ids, nil
+}
+
+func playerFilterQuery(filter PlayerFilter) bson.M {
+	query := bson.M{}
+
+	if filter.IDs != nil {
+		query["_id"] = bson.M{"$in": filter.IDs}
+	}
+
+	firstLogin := bson.M{}
+	if filter.FirstLoginAfter != nil {
+		firstLogin["$gt"] = *filter.FirstLoginAfter
+	}
+	if filter.FirstLoginBefore != nil {
+		firstLogin["$lt"] = *filter.FirstLoginBefore
+	}
+	if len(firstLogin) > 0 {
+		query["firstLogin"] = firstLogin
+	}
+
+	if filter.MinExperience != nil {
+		query["experience"] = bson.M{"$gte": *filter.MinExperience}
+	}
+	if filter.MinPlaytime != nil {
+		query["totalPlaytime"] = bson.M{"$gte": *filter.MinPlaytime}
+	}
+
+	return query
+}
+
+func (m *mongoRepository) CreateBadgeJob(ctx context.Context, job model.BadgeJob) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := m.badgeJobCollection.InsertOne(ctx, job)
+	return err
+}
+
+func (m *mongoRepository) ClaimBadgeJob(ctx context.Context, now time.Time, leaseExpiresAt time.Time) (model.BadgeJob, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{
+		"$or": bson.A{
+			bson.M{"status": model.BadgeJobStatusPending},
+			bson.M{"status": model.BadgeJobStatusRunning, "leaseExpiresAt": bson.M{"$lt": now}},
+		},
+	}
+	update := bson.M{"$set": bson.M{
+		"status":         model.BadgeJobStatusRunning,
+		"leaseExpiresAt": leaseExpiresAt,
+		"leaseToken":     uuid.NewString(),
+		"updatedAt":      now,
+	}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.M{"createdAt": 1}).
+		SetReturnDocument(options.After)
+
+	var job model.BadgeJob
+	if err := m.badgeJobCollection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&job); err != nil {
+		return model.BadgeJob{}, err
+	}
+
+	return job, nil
+}
+
+func (m *mongoRepository) UpdateBadgeJobProgress(ctx context.Context, job model.BadgeJob, leaseExpiresAt time.Time) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	result, err := m.badgeJobCollection.UpdateOne(ctx,
+		bson.M{"_id": job.ID, "status": model.BadgeJobStatusRunning, "leaseToken": job.LeaseToken},
+		bson.M{"$set": bson.M{
+			"progress":       job.Progress,
+			"lastPlayerId":   job.LastPlayerID,
+			"leaseExpiresAt": leaseExpiresAt,
+			"updatedAt":      time.Now(),
+		}})
+	if err != nil {
+		return false, err
+	}
+
+	return result.MatchedCount > 0, nil
+}
+
+func (m *mongoRepository) FinishBadgeJob(ctx context.Context, job model.BadgeJob, status string, jobErr string) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	now := time.Now()
+	update := bson.M{
+		"$set":   bson.M{"status": status, "updatedAt": now, "completedAt": now},
+		"$unset": bson.M{"leaseExpiresAt": "", "leaseToken": ""},
+	}
+	if jobErr != "" {
+		update["$set"].(bson.M)["error"] = jobErr
+	}
+
+	_, err := m.badgeJobCollection.UpdateOne(ctx,
+		bson.M{"_id": job.ID, "status": model.BadgeJobStatusRunning, "leaseToken": job.LeaseToken}, update)
+	return err
+}
+
+func (m *mongoRepository) CancelBadgeJob(ctx context.Context, id primitive.ObjectID) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	now := time.Now()
+	result, err := m.badgeJobCollection.UpdateOne(ctx,
+		bson.M{"_id": id, "status": bson.M{"$in": bson.A{model.BadgeJobStatusPending, model.BadgeJobStatusRunning}}},
+		bson.M{
+			"$set":   bson.M{"status": model.BadgeJobStatusCancelled, "updatedAt": now, "completedAt": now},
+			"$unset": bson.M{"leaseExpiresAt": "", "leaseToken": ""},
+		})
+	if err != nil {
+		return false, err
+	}
+
+	return result.MatchedCount > 0, nil
+}
@@ -0,0 +1,438 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"mc-player-service/internal/repository/model"
+	"time"
+)
+
+func (m *mongoRepository) CreateExperienceBooster(ctx context.Context, booster model.ExperienceBooster) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := m.experienceBoosterCollection.InsertOne(ctx, booster)
+	return err
+}
+
+func (m *mongoRepository) GetActiveExperienceBoosters(ctx context.Context, playerID uuid.UUID, at time.Time) ([]model.ExperienceBooster, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	cursor, err := m.experienceBoosterCollection.Find(ctx, bson.M{"playerId": playerID, "expiresAt": bson.M{"$gt": at}})
+	if err != nil {
+		return nil, err
+	}
+
+	var mongoResult []model.ExperienceBooster
+	if err := cursor.All(ctx, &mongoResult); err != nil {
+		return nil, err
+	}
+
+	return mongoResult, nil
+}
+
+func (m *mongoRepository) DeleteExperienceBooster(ctx context.Context, boosterID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	res, err := m.experienceBoosterCollection.DeleteOne(ctx, bson.M{"_id": boosterID})
+	if err != nil {
+		return err
+	}
+
+	if res.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+
+	return nil
+}
+
+func (m *mongoRepository) GetExperienceGainedSince(ctx context.Context, playerID uuid.UUID, reason string, since time.Time) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	pipeline := []bson.M{
+		{
+			"$match": bson.M{
+				"playerId": playerID,
+				"reason":   reason,
+				"_id":      bson.M{"$gte": primitive.NewObjectIDFromTimestamp(since)},
+				// Compensating transactions keep the reason of the original, but revoking experience
+				// mustn't give the player more headroom under the cap
+				"revokes": bson.M{"$exists": false},
+			},
+		},
+		{
+			"$group": bson.M{
+				"_id":   nil,
+				"total": bson.M{"$sum": "$amount"},
+			},
+		},
+	}
+
+	cursor, err := m.experienceTransactionCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return 0, err
+	}
+
+	var mongoResult []struct {
+		Total int64 `bson:"total"`
+	}
+	if err := cursor.All(ctx, &mongoResult); err != nil {
+		return 0, err
+	}
+
+	if len(mongoResult) == 0 {
+		return 0, nil
+	}
+
+	return mongoResult[0].Total, nil
+}
+
+func (m *mongoRepository) GetExperienceTransaction(ctx context.Context, id primitive.ObjectID) (model.ExperienceTransaction, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var mongoResult model.ExperienceTransaction
+	if err := m.experienceTransactionCollection.FindOne(ctx, bson.M{"_id": id}).Decode(&mongoResult); err != nil {
+		return model.ExperienceTransaction{}, err
+	}
+
+	return mongoResult, nil
+}
+
+func (m *mongoRepository) GetRevocableExperienceTransactions(ctx context.Context, reason string, from time.Time,
+	to time.Time) ([]model.ExperienceTransaction, error) {
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	cursor, err := m.experienceTransactionCollection.Find(ctx, bson.M{
+		"reason": reason,
+		"_id": bson.M{
+			"$gte": primitive.NewObjectIDFromTimestamp(from),
+			"$lt":  primitive.NewObjectIDFromTimestamp(to),
+		},
+		"revokedBy": bson.M{"$exists": false},
+		"revokes":   bson.M{"$exists": false},
+		// Transactions that removed experience, e.g. prestiges, must never be revoked
+		"amount": bson.M{"$gt": 0},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var mongoResult []model.ExperienceTransaction
+	if err := cursor.All(ctx, &mongoResult); err != nil {
+		return nil, err
+	}
+
+	return mongoResult, nil
+}
+
+func (m *mongoRepository) MarkExperienceTransactionRevoked(ctx context.Context, id primitive.ObjectID, revokedBy primitive.ObjectID) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	res, err := m.experienceTransactionCollection.UpdateOne(ctx,
+		bson.M{"_id": id, "revokedBy": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"revokedBy": revokedBy}})
+	if err != nil {
+		return false, err
+	}
+
+	return res.ModifiedCount > 0, nil
+}
+
+func (m *mongoRepository) UnmarkExperienceTransactionRevoked(ctx context.Context, id primitive.ObjectID, revokedBy primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := m.experienceTransactionCollection.UpdateOne(ctx,
+		bson.M{"_id": id, "revokedBy": revokedBy},
+		bson.M{"$unset": bson.M{"revokedBy": ""}})
+	return err
+}
+
+func (m *mongoRepository) CreateLevelRewardClaim(ctx context.Context, claim model.LevelRewardClaim) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if _, err := m.levelRewardClaimCollection.InsertOne(ctx, claim); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (m *mongoRepository) CreateFailedExperienceGrant(ctx context.Context, grant model.FailedExperienceGrant) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := m.failedExperienceGrantCollection.InsertOne(ctx, grant)
+	return err
+}
+
+func (m *mongoRepository) GetDueFailedExperienceGrants(ctx context.Context, at time.Time, limit int) ([]model.FailedExperienceGrant, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	cursor, err := m.failedExperienceGrantCollection.Find(ctx, bson.M{"nextAttemptAt": bson.M{"$lte": at}},
+		options.Find().SetSort(bson.M{"nextAttemptAt": 1}).SetLimit(int64(limit)))
+	if err != nil {
+		return nil, err
+	}
+
+	var grants []model.FailedExperienceGrant
+	if err := cursor.All(ctx, &grants); err != nil {
+		return nil, err
+	}
+
+	return grants, nil
+}
+
+func (m *mongoRepository) RescheduleFailedExperienceGrant(ctx context.Context, id primitive.ObjectID, nextAttemptAt time.Time,
+	lastError string) error {
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := m.failedExperienceGrantCollection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{
+		"$set": bson.M{"nextAttemptAt": nextAttemptAt, "lastError": lastError},
+		"$inc": bson.M{"attempts": 1},
+	})
+	return err
+}
+
+func (m *mongoRepository) DeleteFailedExperienceGrant(ctx context.Context, id primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := m.failedExperienceGrantCollection.DeleteOne(ctx, bson.M{"_id": id})
+	return err
+}
+
+func (m *mongoRepository) DeleteLevelRewardClaim(ctx context.Context, id primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := m.levelRewardClaimCollection.DeleteOne(ctx, bson.M{"_id": id})
+	return err
+}
+
+func (m *mongoRepository) GetClaimedRewardLevels(ctx context.Context, playerID uuid.UUID) ([]int, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	cursor, err := m.levelRewardClaimCollection.Find(ctx, bson.M{"playerId": playerID},
+		options.Find().SetProjection(bson.M{"level": 1}))
+	if err != nil {
+		return nil, err
+	}
+
+	var claims []model.LevelRewardClaim
+	if err := cursor.All(ctx, &claims); err != nil {
+		return nil, err
+	}
+
+	levels := make([]int, len(claims))
+	for i, claim := range claims {
+		levels[i] = claim.Level
+	}
+
+	return levels, nil
+}
+
+func (m *mongoRepository) GetExperienceMismatches(ctx context.Context) ([]model.ExperienceMismatch, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Minute)
+	defer cancel()
+
+	pipeline := []bson.M{
+		{
+			"$lookup": bson.M{
+				"from": experienceTransactionCollectionName,
+				"let":  bson.M{"playerId": "$_id"},
+				"pipeline": []bson.M{
+					{"$match": bson.M{"$expr": bson.M{"$eq": bson.A{"$playerId", "$$playerId"}}}},
+					{"$group": bson.M{"_id": nil, "total": bson.M{"$sum": "$amount"}}},
+				},
+				"as": "ledger",
+			},
+		},
+		{
+			"$project": bson.M{
+				"experience":       bson.M{"$ifNull": bson.A{"$experience", 0}},
+				"ledgerExperience": bson.M{"$ifNull": bson.A{bson.M{"$first": "$ledger.total"}, 0}},
+			},
+		},
+		{
+			"$match": bson.M{"$expr": bson.M{"$ne": bson.A{"$experience", "$ledgerExperience"}}},
+		},
+	}
+
+	cursor, err := m.playerCollection.Aggregate(ctx, pipeline, options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		return nil, err
+	}
+
+	var mongoResult []model.ExperienceMismatch
+	if err := cursor.All(ctx, &mongoResult); err != nil {
+		return nil, err
+	}
+
+	return mongoResult, nil
+}
+
+func (m *mongoRepository) GetUnarchivedSeasonResults(ctx context.Context, seasonID string, limit int) ([]model.SeasonResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	field := "seasonExperience." + seasonID
+	cursor, err := m.playerCollection.Find(ctx, bson.M{field: bson.M{"$exists": true}},
+		options.Find().SetLimit(int64(limit)).SetProjection(bson.M{"_id": 1, field: 1}))
+	if err != nil {
+		return nil, err
+	}
+
+	var players []model.Player
+	if err := cursor.All(ctx, &players); err != nil {
+		return nil, err
+	}
+
+	results := make([]model.SeasonResult, len(players))
+	for i, p := range players {
+		results[i] = model.SeasonResult{
+			PlayerID:   p.ID,
+			SeasonID:   seasonID,
+			Experience: p.SeasonExperience[seasonID],
+		}
+	}
+
+	return results, nil
+}
+
+func (m *mongoRepository) ArchiveSeasonResults(ctx context.Context, results []model.SeasonResult) error {
+	if len(results) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	docs := make([]interface{}, len(results))
+	playerIDs := make([]uuid.UUID, len(results))
+	for i, result := range results {
+		docs[i] = result
+		playerIDs[i] = result.PlayerID
+	}
+
+	// Unordered so that a result archived by a previous, interrupted run doesn't stop the others
+	if _, err := m.seasonResultCollection.InsertMany(ctx, docs, options.InsertMany().SetOrdered(false)); err != nil &&
+		!mongo.IsDuplicateKeyError(err) {
+		return err
+	}
+
+	_, err := m.playerCollection.UpdateMany(ctx, bson.M{"_id": bson.M{"$in": playerIDs}},
+		bson.M{"$unset": bson.M{"seasonExperience." + results[0].SeasonID: ""}})
+	return err
+}
+
+func (m *mongoRepository) GetPlayerSeasonResults(ctx context.Context, playerID uuid.UUID) ([]model.SeasonResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	cursor, err := m.seasonResultCollection.Find(ctx, bson.M{"playerId": playerID}, options.Find().SetSort(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, err
+	}
+
+	var mongoResult []model.SeasonResult
+	if err := cursor.All(ctx, &mongoResult); err != nil {
+		return nil, err
+	}
+
+	return mongoResult, nil
+}
+
+func (m *mongoRepository) GetArchivedSeasonLeaderboard(ctx context.Context, seasonID string, limit int) ([]model.SeasonResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	cursor, err := m.seasonResultCollection.Find(ctx, bson.M{"seasonId": seasonID},
+		options.Find().SetSort(bson.M{"experience": -1}).SetLimit(int64(limit)))
+	if err != nil {
+		return nil, err
+	}
+
+	var mongoResult []model.SeasonResult
+	if err := cursor.All(ctx, &mongoResult); err != nil {
+		return nil, err
+	}
+
+	return mongoResult, nil
+}
+
+func (m *mongoRepository) GetSeasonLeaderboard(ctx context.Context, seasonID string, limit int) ([]model.SeasonResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	field := "seasonExperience." + seasonID
+	cursor, err := m.playerCollection.Find(ctx, bson.M{field: bson.M{"$exists": true}},
+		options.Find().SetSort(bson.M{field: -1}).SetLimit(int64(limit)).SetProjection(bson.M{"_id": 1, field: 1}))
+	if err != nil {
+		return nil, err
+	}
+
+	var players []model.Player
+	if err := cursor.All(ctx, &players); err != nil {
+		return nil, err
+	}
+
+	results := make([]model.SeasonResult, len(players))
+	for i, p := range players {
+		results[i] = model.SeasonResult{
+			PlayerID:   p.ID,
+			SeasonID:   seasonID,
+			Experience: p.SeasonExperience[seasonID],
+		}
+	}
+
+	return results, nil
+}
+
+func (m *mongoRepository) GetPlayerExperience(ctx context.Context, playerID uuid.UUID) (model.PlayerExperience, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var mongoResult model.PlayerExperience
+	if err := m.playerCollection.FindOne(ctx, bson.M{"_id": playerID},
+		options.FindOne().SetProjection(model.PlayerExperienceProjection)).Decode(&mongoResult); err != nil {
+		return model.PlayerExperience{}, err
+	}
+
+	return mongoResult, nil
+}
+
+func (m *mongoRepository) PrestigePlayer(ctx context.Context, playerID uuid.UUID, expectedXP int64) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	result := m.playerCollection.FindOneAndUpdate(ctx, bson.M{"_id": playerID, "experience": expectedXP},
+		bson.M{"$set": bson.M{"experience": 0}, "$inc": bson.M{"prestige": 1}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After).SetProjection(model.PlayerExperienceProjection))
+
+	var mongoResult model.PlayerExperience
+	if err := result.Decode(&mongoResult); err != nil {
+		return 0, err
+	}
+
+	return mongoResult.Prestige, nil
+}
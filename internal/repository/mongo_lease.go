@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+func (m *mongoRepository) AcquireLease(ctx context.Context, name string, holder string, now time.Time,
+	expiresAt time.Time) (bool, error) {
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	// If another holder has an unexpired lease the filter doesn't match,
+	// so the upsert tries to insert a second lease with the same ID and fails
+	_, err := m.leaseCollection.UpdateOne(ctx,
+		bson.M{"_id": name, "$or": bson.A{bson.M{"holder": holder}, bson.M{"expiresAt": bson.M{"$lte": now}}}},
+		bson.M{"$set": bson.M{"holder": holder, "expiresAt": expiresAt}},
+		options.Update().SetUpsert(true))
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (m *mongoRepository) ReleaseLease(ctx context.Context, name string, holder string) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := m.leaseCollection.DeleteOne(ctx, bson.M{"_id": name, "holder": holder})
+	return err
+}
@@ -65,11 +65,24 @@ func (m *mongoRepository) GetPlayers(ctx context.Context, pIds []uuid.UUID) ([]m
 	return mongoResult, nil
 }
 
-func (m *mongoRepository) SavePlayer(ctx context.Context, player model.Player, upsert bool) error {
+func (m *mongoRepository) SavePlayerLogin(ctx context.Context, player model.Player) error {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	_, err := m.playerCollection.UpdateOne(ctx, bson.M{"_id": player.ID}, bson.M{"$set": player}, options.Update().SetUpsert(upsert))
+	_, err := m.playerCollection.UpdateOne(ctx, bson.M{"_id": player.ID}, bson.M{
+		"$set": bson.M{
+			"currentUsername": player.CurrentUsername,
+			"currentSkin":     player.CurrentSkin,
+			"currentServer":   player.CurrentServer,
+			"lastOnline":      player.LastOnline,
+			"lastLogin":       player.LastLogin,
+			"loginStreak":     player.LoginStreak,
+		},
+		"$setOnInsert": bson.M{
+			"firstLogin":    player.FirstLogin,
+			"totalPlaytime": player.TotalPlaytime,
+		},
+	}, options.Update().SetUpsert(true))
 	return err
 }
 
@@ -219,24 +232,118 @@ func (m *mongoRepository) CreatePlayerUsername(ctx context.Context, username mod
 	_, err := m.usernameCollection.InsertOne(ctx, username)
 	return err
 }
-func (m *mongoRepository) AddExperienceToPlayer(ctx context.Context, playerID uuid.UUID, experience int) (int, error) {
+func (m *mongoRepository) AddExperienceToPlayer(ctx context.Context, playerID uuid.UUID, experience int, maxExperience int,
+	tracks model.ExperienceTracks) (model.PlayerExperience, int, error) {
+
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	result := m.playerCollection.FindOneAndUpdate(ctx, bson.M{"_id": playerID}, bson.M{"$inc": bson.M{"experience": experience}},
-		options.FindOneAndUpdate().SetReturnDocument(options.After).SetProjection(bson.M{"experience": 1}))
+	// A pipeline update is used so the level cap is applied atomically. The amount that is added
+	// is stored in a temporary field so that the tracks are given the same amount.
+	var added any = experience
+	if maxExperience != -1 {
+		remaining := bson.M{"$max": bson.A{0, bson.M{"$subtract": bson.A{maxExperience, bson.M{"$ifNull": bson.A{"$experience", 0}}}}}}
+		added = bson.M{"$min": bson.A{experience, remaining}}
+	}
+
+	set := bson.M{"experience": bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$experience", 0}}, "$_added"}}}
+	if tracks.SeasonID != "" {
+		field := "seasonExperience." + tracks.SeasonID
+		set[field] = bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$" + field, 0}}, "$_added"}}
+	}
+	if tracks.GameModeID != "" {
+		field := "gameModeExperience." + tracks.GameModeID
+		set[field] = bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$" + field, 0}}, "$_added"}}
+	}
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{"_added": added}}},
+		{{Key: "$set", Value: set}},
+		{{Key: "$unset", Value: "_added"}},
+	}
+
+	result := m.playerCollection.FindOneAndUpdate(ctx, bson.M{"_id": playerID}, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.Before).SetProjection(model.PlayerExperienceProjection))
 	if result.Err() != nil {
-		return 0, fmt.Errorf("error adding experience to player: %w", result.Err())
+		return model.PlayerExperience{}, 0, fmt.Errorf("error adding experience to player: %w", result.Err())
+	}
+
+	var before model.PlayerExperience
+	if err := result.Decode(&before); err != nil {
+		return model.PlayerExperience{}, 0, fmt.Errorf("error decoding player: %w", err)
+	}
+
+	if maxExperience != -1 {
+		experience = min(experience, max(maxExperience-int(before.Experience), 0))
+	}
+
+	return before, experience, nil
+}
+
+func (m *mongoRepository) SetPlayerExperience(ctx context.Context, playerID uuid.UUID, expectedXP int64, newXP int64) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	// A player that has never gained experience has no experience field
+	expectedFilter := bson.M{"experience": expectedXP}
+	if expectedXP == 0 {
+		expectedFilter = bson.M{"$or": bson.A{
+			bson.M{"experience": 0},
+			bson.M{"experience": bson.M{"$exists": false}},
+		}}
+	}
+
+	res, err := m.playerCollection.UpdateOne(ctx, bson.M{"$and": bson.A{bson.M{"_id": playerID}, expectedFilter}},
+		bson.M{"$set": bson.M{"experience": newXP}})
+	if err != nil {
+		return false, err
+	}
+
+	return res.MatchedCount > 0, nil
+}
+
+func (m *mongoRepository) RemoveExperienceFromPlayer(ctx context.Context, playerID uuid.UUID, experience int,
+	seasonExperience map[string]int, gameModeExperience map[string]int) (int, int, error) {
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	// A pipeline update is used so the floor at zero is applied atomically
+	set := bson.M{"experience": floorSubtract("$experience", experience)}
+	for seasonID, seasonXP := range seasonExperience {
+		field := "seasonExperience." + seasonID
+		// Archived seasons have been removed from the player and must not be recreated
+		set[field] = bson.M{"$cond": bson.A{
+			bson.M{"$eq": bson.A{bson.M{"$type": "$" + field}, "missing"}},
+			"$$REMOVE",
+			floorSubtract("$"+field, seasonXP),
+		}}
+	}
+	for gameModeID, gameModeXP := range gameModeExperience {
+		field := "gameModeExperience." + gameModeID
+		set[field] = floorSubtract("$"+field, gameModeXP)
+	}
+	update := mongo.Pipeline{{{Key: "$set", Value: set}}}
+
+	result := m.playerCollection.FindOneAndUpdate(ctx, bson.M{"_id": playerID}, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.Before).SetProjection(bson.M{"experience": 1}))
+	if result.Err() != nil {
+		return 0, 0, fmt.Errorf("error removing experience from player: %w", result.Err())
 	}
 
 	var experienceResult struct {
 		Experience int `bson:"experience"`
 	}
 	if err := result.Decode(&experienceResult); err != nil {
-		return 0, fmt.Errorf("error decoding player: %w", err)
+		return 0, 0, fmt.Errorf("error decoding player: %w", err)
 	}
 
-	return experienceResult.Experience, nil
+	oldXP := experienceResult.Experience
+	return oldXP, max(oldXP-experience, 0), nil
+}
+
+// floorSubtract creates an aggregation expression subtracting the amount from the field without going below zero
+func floorSubtract(field string, amount int) bson.M {
+	return bson.M{"$max": bson.A{0, bson.M{"$subtract": bson.A{bson.M{"$ifNull": bson.A{field, 0}}, amount}}}}
 }
 
 func (m *mongoRepository) CreateExperienceTransaction(ctx context.Context, transaction model.ExperienceTransaction) error {
@@ -247,6 +354,23 @@ func (m *mongoRepository) CreateExperienceTransaction(ctx context.Context, trans
 	return err
 }
 
+func (m *mongoRepository) SetExperienceTransactionAmount(ctx context.Context, id primitive.ObjectID, amount int64, capped bool) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := m.experienceTransactionCollection.UpdateOne(ctx, bson.M{"_id": id},
+		bson.M{"$set": bson.M{"amount": amount, "capped": capped}})
+	return err
+}
+
+func (m *mongoRepository) DeleteExperienceTransaction(ctx context.Context, id primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := m.experienceTransactionCollection.DeleteOne(ctx, bson.M{"_id": id})
+	return err
+}
+
 func (m *mongoRepository) GetTotalUniquePlayers(ctx context.Context) (int64, error) {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
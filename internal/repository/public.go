@@ -4,27 +4,118 @@ import (
 	"context"
 	"github.com/emortalmc/proto-specs/gen/go/model/common"
 	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"mc-player-service/internal/config"
 	"mc-player-service/internal/repository/model"
 	"time"
 )
 
 type Repository interface {
 	BadgeReadWriter
+	BadgeCatalogueReadWriter
+	BadgeJobReadWriter
 	PlayerReadWriter
+	LeaseWriter
+
+	// CreateSeasonLeaderboardIndexes creates the indexes used by GetSeasonLeaderboard for each season
+	CreateSeasonLeaderboardIndexes(ctx context.Context, seasonIDs []string) error
 
 	Ping(ctx context.Context) error
 }
 
 type BadgeReader interface {
 	GetActivePlayerBadge(ctx context.Context, playerId uuid.UUID) (*string, error)
+	// GetBadgeAuditRecords returns audit records newest first, filtered by player and badge if not nil
+	GetBadgeAuditRecords(ctx context.Context, playerID *uuid.UUID, badgeID *string, pageable *common.Pageable) ([]model.BadgeAuditRecord, *common.PageData, error)
 	GetBadgePlayer(ctx context.Context, playerId uuid.UUID) (model.BadgePlayer, error)
+	// GetBadgePlayersAfter returns up to limit players with an ID greater than after, ordered by ID
+	GetBadgePlayersAfter(ctx context.Context, after uuid.UUID, limit int) ([]model.BadgePlayer, error)
+	GetCriteriaPlayer(ctx context.Context, playerID uuid.UUID) (model.CriteriaPlayer, error)
+	// GetCriteriaPlayersAfter returns up to limit players with an ID greater than after, ordered by ID
+	GetCriteriaPlayersAfter(ctx context.Context, after uuid.UUID, limit int) ([]model.CriteriaPlayer, error)
+	// GetExpiredBadgeGrants returns up to limit badge grants that have expired at the given time
+	GetExpiredBadgeGrants(ctx context.Context, at time.Time, limit int) ([]model.ExpiredBadgeGrant, error)
+	// GetBadgeOwnerCounts returns the number of players that own each badge. Badges nobody owns are not included.
+	GetBadgeOwnerCounts(ctx context.Context) (map[string]int64, error)
+	// GetPlayerIDsWithBadges returns the IDs of all players that own at least one of the badges
+	GetPlayerIDsWithBadges(ctx context.Context, badgeIDs []string) ([]uuid.UUID, error)
+}
+
+type BadgeCatalogueReader interface {
+	// GetCatalogueBadges returns every badge in the catalogue, ordered by ID
+	GetCatalogueBadges(ctx context.Context, includeArchived bool) ([]model.CatalogueBadge, error)
+	// GetBadgeCatalogueRevision returns the number of changes made to the catalogue, see ClaimBadgeCatalogueRevision
+	GetBadgeCatalogueRevision(ctx context.Context) (int64, error)
+}
+
+type BadgeCatalogueWriter interface {
+	// CreateCatalogueBadge returns a duplicate key error if a badge with the same ID exists
+	CreateCatalogueBadge(ctx context.Context, badge config.Badge) error
+	// UpdateCatalogueBadge returns mongo.ErrNoDocuments if the badge does not exist
+	UpdateCatalogueBadge(ctx context.Context, badge config.Badge) error
+	// UpsertCatalogueBadge creates the badge, or replaces it if overwrite is true. False is returned if
+	// the badge already exists and wasn't overwritten.
+	UpsertCatalogueBadge(ctx context.Context, badge config.Badge, overwrite bool) (bool, error)
+	// SetCatalogueBadgeArchived returns mongo.ErrNoDocuments if the badge does not exist
+	SetCatalogueBadgeArchived(ctx context.Context, badgeID string, archived bool) error
+	// SetCatalogueBadgePriorities sets the priority of each badge, keyed by badge ID
+	SetCatalogueBadgePriorities(ctx context.Context, priorities map[string]int) error
+
+	// ClaimBadgeCatalogueRevision increments the catalogue revision if it is still revision and no other change
+	// is being written, marking a change as being written until FinishBadgeCatalogueChange or expiresAt.
+	// False is returned if the catalogue has changed or another change is being written.
+	ClaimBadgeCatalogueRevision(ctx context.Context, revision int64, now time.Time, expiresAt time.Time) (bool, error)
+	// FinishBadgeCatalogueChange marks the change that claimed revision as written
+	FinishBadgeCatalogueChange(ctx context.Context, revision int64) error
+}
+
+type BadgeCatalogueReadWriter interface {
+	BadgeCatalogueReader
+	BadgeCatalogueWriter
+}
+
+type BadgeJobReader interface {
+	// GetBadgeJob returns mongo.ErrNoDocuments if the job does not exist
+	GetBadgeJob(ctx context.Context, id primitive.ObjectID) (model.BadgeJob, error)
+	// GetBadgeJobs returns jobs newest first. Their PlayerIDs are not included.
+	GetBadgeJobs(ctx context.Context, pageable *common.Pageable) ([]model.BadgeJob, *common.PageData, error)
+
+	CountFilteredPlayers(ctx context.Context, filter PlayerFilter) (int64, error)
+	// GetFilteredPlayerIDsAfter returns up to limit IDs of players matching the filter with an ID greater than after, ordered by ID
+	GetFilteredPlayerIDsAfter(ctx context.Context, filter PlayerFilter, after uuid.UUID, limit int) ([]uuid.UUID, error)
+}
+
+type BadgeJobWriter interface {
+	CreateBadgeJob(ctx context.Context, job model.BadgeJob) error
+	// ClaimBadgeJob marks the oldest pending job, or running job whose lease has expired, as running until leaseExpiresAt.
+	// mongo.ErrNoDocuments is returned if there are no jobs to run.
+	ClaimBadgeJob(ctx context.Context, now time.Time, leaseExpiresAt time.Time) (model.BadgeJob, error)
+	// UpdateBadgeJobProgress saves the job's progress and extends its lease, returning false if the job
+	// is no longer running (e.g. it was cancelled) or job.LeaseToken is no longer its lease
+	UpdateBadgeJobProgress(ctx context.Context, job model.BadgeJob, leaseExpiresAt time.Time) (bool, error)
+	// FinishBadgeJob sets the status of a running job, which should be completed or failed.
+	// Nothing is changed if job.LeaseToken is no longer its lease.
+	FinishBadgeJob(ctx context.Context, job model.BadgeJob, status string, jobErr string) error
+	// CancelBadgeJob returns false if the job has already finished
+	CancelBadgeJob(ctx context.Context, id primitive.ObjectID) (bool, error)
+}
+
+type BadgeJobReadWriter interface {
+	BadgeJobReader
+	BadgeJobWriter
 }
 
 type BadgeWriter interface {
-	AddPlayerBadge(ctx context.Context, playerId uuid.UUID, badgeId string) (int64, error)
-	SetActivePlayerBadge(ctx context.Context, playerId uuid.UUID, badgeId *string) error
+	// AddPlayerBadge gives the player the badge until expiresAt, or permanently if nil.
+	// 0 is returned if the player already has the badge.
+	AddPlayerBadge(ctx context.Context, playerId uuid.UUID, badgeId string, expiresAt *time.Time) (int64, error)
+	// SetActivePlayerBadge manual is whether the player chose the badge, see model.BadgePlayer.ActiveBadgeManual
+	SetActivePlayerBadge(ctx context.Context, playerId uuid.UUID, badgeId *string, manual bool) error
 	RemovePlayerBadge(ctx context.Context, playerId uuid.UUID, badgeId string) (int64, error)
-	UpdatePlayerBadgesAndActive(ctx context.Context, playerId uuid.UUID, badges []string, activeBadge *string) error
+	UpdatePlayerBadgesAndActive(ctx context.Context, playerId uuid.UUID, badges []string, activeBadge *string, manual bool) error
+	CreateBadgeAuditRecord(ctx context.Context, record model.BadgeAuditRecord) error
+	// RemoveExpiredPlayerBadge removes the badge if its grant has expired at the given time, returning false if it hasn't
+	RemoveExpiredPlayerBadge(ctx context.Context, playerID uuid.UUID, badgeID string, at time.Time) (bool, error)
 }
 
 type BadgeReadWriter interface {
@@ -56,21 +147,113 @@ type PlayerReader interface {
 	GetFleetPlayerCounts(ctx context.Context, fleetNames []string) (map[string]int64, error)
 
 	GetTotalUniquePlayers(ctx context.Context) (int64, error)
+	GetGitHubLinkedPlayers(ctx context.Context) ([]model.GitHubPlayer, error)
 	GetTotalPlaytimeHours(ctx context.Context) (int64, error)
+
+	GetPlayerExperience(ctx context.Context, playerID uuid.UUID) (model.PlayerExperience, error)
+
+	// GetActiveExperienceBoosters returns the player's boosters that have not expired at the given time
+	GetActiveExperienceBoosters(ctx context.Context, playerID uuid.UUID, at time.Time) ([]model.ExperienceBooster, error)
+
+	// GetExperienceGainedSince sums the player's experience transactions for the reason created since the given time.
+	// Compensating transactions are not included.
+	GetExperienceGainedSince(ctx context.Context, playerID uuid.UUID, reason string, since time.Time) (int64, error)
+
+	GetExperienceTransaction(ctx context.Context, id primitive.ObjectID) (model.ExperienceTransaction, error)
+
+	// GetDueFailedExperienceGrants returns up to limit failed grants whose next attempt is at or before the given time
+	GetDueFailedExperienceGrants(ctx context.Context, at time.Time, limit int) ([]model.FailedExperienceGrant, error)
+
+	// GetClaimedRewardLevels returns the levels the player has claimed the rewards for
+	GetClaimedRewardLevels(ctx context.Context, playerID uuid.UUID) ([]int, error)
+
+	// GetRevocableExperienceTransactions returns all transactions for the reason created within [from, to)
+	// that granted experience and have not been revoked
+	GetRevocableExperienceTransactions(ctx context.Context, reason string, from time.Time, to time.Time) ([]model.ExperienceTransaction, error)
+
+	// GetExperienceMismatches returns every player whose stored experience differs from the sum of their transactions
+	GetExperienceMismatches(ctx context.Context) ([]model.ExperienceMismatch, error)
+
+	// GetUnarchivedSeasonResults returns up to limit players that still hold experience for the season.
+	// The returned results have no ID or Level.
+	GetUnarchivedSeasonResults(ctx context.Context, seasonID string, limit int) ([]model.SeasonResult, error)
+	GetPlayerSeasonResults(ctx context.Context, playerID uuid.UUID) ([]model.SeasonResult, error)
+	// GetArchivedSeasonLeaderboard returns the top archived results for a season that has ended
+	GetArchivedSeasonLeaderboard(ctx context.Context, seasonID string, limit int) ([]model.SeasonResult, error)
+	// GetSeasonLeaderboard returns the top players by experience for a season that has not been archived
+	GetSeasonLeaderboard(ctx context.Context, seasonID string, limit int) ([]model.SeasonResult, error)
 }
 
 type PlayerWriter interface {
-	SavePlayer(ctx context.Context, player model.Player, upsert bool) error
+	// SavePlayerLogin saves the fields a login changes: the username, skin, current server, last online,
+	// last login and login streak, creating the player with FirstLogin if they don't exist.
+	// Other fields are left alone so that changes made since the player was read aren't overwritten.
+	SavePlayerLogin(ctx context.Context, player model.Player) error
 	PlayerLogout(ctx context.Context, playerID uuid.UUID, lastOnline time.Time, addedPlaytime time.Duration) error
 
 	CreateLoginSession(ctx context.Context, session model.LoginSession) error
 	SetLoginSessionLogoutTime(ctx context.Context, playerID uuid.UUID, logoutTime time.Time) error
 	CreatePlayerUsername(ctx context.Context, username model.PlayerUsername) error
 
-	AddExperienceToPlayer(ctx context.Context, playerID uuid.UUID, experience int) (int, error)
+	// AddExperienceToPlayer adds to the player's lifetime experience and their experience for each of the tracks.
+	// Only the experience that keeps the player at or below maxExperience is added, unless maxExperience is -1.
+	// The player's experience before the update and the amount that was added are returned.
+	AddExperienceToPlayer(ctx context.Context, playerID uuid.UUID, experience int, maxExperience int,
+		tracks model.ExperienceTracks) (before model.PlayerExperience, added int, err error)
+	// PrestigePlayer resets the player's experience and increments their prestige if their experience
+	// is still expectedXP, returning the new prestige. mongo.ErrNoDocuments is returned if the experience has changed.
+	PrestigePlayer(ctx context.Context, playerID uuid.UUID, expectedXP int64) (int, error)
+	// SetPlayerExperience only updates the player if their experience is still expectedXP, returning false otherwise
+	SetPlayerExperience(ctx context.Context, playerID uuid.UUID, expectedXP int64, newXP int64) (bool, error)
+	// RemoveExperienceFromPlayer removes experience without letting it drop below zero and returns
+	// the player's lifetime experience before and after the removal. seasonExperience (keyed by season ID)
+	// is removed from seasons that have not been archived yet and gameModeExperience from each game mode.
+	RemoveExperienceFromPlayer(ctx context.Context, playerID uuid.UUID, experience int,
+		seasonExperience map[string]int, gameModeExperience map[string]int) (oldXP int, newXP int, err error)
+	// CreateExperienceTransaction returns a duplicate key error if the player already has a transaction
+	// with the transaction's idempotency key
 	CreateExperienceTransaction(ctx context.Context, transaction model.ExperienceTransaction) error
+	DeleteExperienceTransaction(ctx context.Context, id primitive.ObjectID) error
+	// SetExperienceTransactionAmount lowers the amount of a transaction that was created before the experience was added
+	SetExperienceTransactionAmount(ctx context.Context, id primitive.ObjectID, amount int64, capped bool) error
+	// MarkExperienceTransactionRevoked returns false if the transaction was already revoked
+	MarkExperienceTransactionRevoked(ctx context.Context, id primitive.ObjectID, revokedBy primitive.ObjectID) (bool, error)
+	// UnmarkExperienceTransactionRevoked undoes MarkExperienceTransactionRevoked if the transaction is still marked as
+	// revoked by revokedBy, so that it can be revoked again
+	UnmarkExperienceTransactionRevoked(ctx context.Context, id primitive.ObjectID, revokedBy primitive.ObjectID) error
+
+	CreateFailedExperienceGrant(ctx context.Context, grant model.FailedExperienceGrant) error
+	// RescheduleFailedExperienceGrant records another failed attempt at the grant
+	RescheduleFailedExperienceGrant(ctx context.Context, id primitive.ObjectID, nextAttemptAt time.Time, lastError string) error
+	DeleteFailedExperienceGrant(ctx context.Context, id primitive.ObjectID) error
+
+	// CreateLevelRewardClaim returns false if the player has already claimed the rewards for the level
+	CreateLevelRewardClaim(ctx context.Context, claim model.LevelRewardClaim) (bool, error)
+	// DeleteLevelRewardClaim allows the rewards for the claim's level to be claimed again
+	DeleteLevelRewardClaim(ctx context.Context, id primitive.ObjectID) error
+
+	// ArchiveSeasonResults stores the results and removes the season's experience from each player.
+	// Results that have already been archived are ignored.
+	ArchiveSeasonResults(ctx context.Context, results []model.SeasonResult) error
+
+	CreateExperienceBooster(ctx context.Context, booster model.ExperienceBooster) error
+	// DeleteExperienceBooster returns mongo.ErrNoDocuments if the booster does not exist
+	DeleteExperienceBooster(ctx context.Context, boosterID primitive.ObjectID) error
 
 	SetPlayerServerAndFleet(ctx context.Context, playerId uuid.UUID, serverId string, fleet string) error
+
+	// SetPlayerGitHubUsername links the GitHub account to the player, or unlinks it if nil.
+	// A duplicate key error is returned if the account is linked to another player.
+	SetPlayerGitHubUsername(ctx context.Context, playerID uuid.UUID, username *string) error
+}
+
+// LeaseWriter leases make sure background work is only run by one replica at a time
+type LeaseWriter interface {
+	// AcquireLease takes the named lease for the holder until expiresAt, or extends it if the holder already has it.
+	// False is returned if another holder's lease hasn't expired yet.
+	AcquireLease(ctx context.Context, name string, holder string, now time.Time, expiresAt time.Time) (bool, error)
+	// ReleaseLease lets another holder take the lease straight away. Nothing is changed if the holder no longer has it.
+	ReleaseLease(ctx context.Context, name string, holder string) error
 }
 
 type PlayerReadWriter interface {
@@ -78,6 +261,16 @@ type PlayerReadWriter interface {
 	PlayerWriter
 }
 
+// PlayerFilter matches players that meet every condition that is present
+type PlayerFilter struct {
+	// IDs if not nil, only these players are matched
+	IDs              []uuid.UUID
+	FirstLoginAfter  *time.Time
+	FirstLoginBefore *time.Time
+	MinExperience    *int64
+	MinPlaytime      *time.Duration
+}
+
 type UsernameSearchFilter struct {
 	OnlineOnly bool
 	Friends    bool
@@ -2,17 +2,29 @@ package experience
 
 import "math"
 
-const (
-	a = 0.15
-	b = float64(2)
-)
+// Curve maps experience to levels using level = A * xp^(1/B)
+type Curve struct {
+	A float64
+	B float64
+}
 
-func XPToLevel(xp int) int {
-	level := a * math.Pow(float64(xp), 1/b)
+// DefaultCurve the curve used for a player's global level
+var DefaultCurve = Curve{A: 0.15, B: 2}
+
+func (c Curve) XPToLevel(xp int) int {
+	level := c.A * math.Pow(float64(xp), 1/c.B)
 	return int(level)
 }
 
-func LevelToXP(level int) int {
-	xp := math.Pow(float64(level)/a, b)
+func (c Curve) LevelToXP(level int) int {
+	xp := math.Pow(float64(level)/c.A, c.B)
 	return int(math.Ceil(xp))
 }
+
+func XPToLevel(xp int) int {
+	return DefaultCurve.XPToLevel(xp)
+}
+
+func LevelToXP(level int) int {
+	return DefaultCurve.LevelToXP(level)
+}